@@ -1,15 +1,162 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	_ "github.com/mattn/go-sqlite3"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
 	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/api"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/eventbus"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
 )
 
 func main() {
-	c, err := category.NewCategory("Filmes", "A categoria mais assistida", true)
+	addr := flag.String("addr", ":8080", "HTTP listen address")
+	dbDriver := flag.String("db-driver", "sqlite3", "database/sql driver name")
+	dbDSN := flag.String("db-dsn", "", "database connection string; in-memory storage is used when empty")
+	changesFile := flag.String("changes-file", "", "file every catalog change is appended to as NDJSON and offsets resume from on restart; disabled when empty")
+	changesRetention := flag.Int("changes-retention", 10000, "number of latest changes kept for GET /changes with in-memory storage; the database keeps all of them")
+	cursorSecret := flag.String("cursor-secret", "", "key that signs listing cursor tokens; a random one, valid until restart, is used when empty")
+	requestTimeout := flag.Duration("request-timeout", 10*time.Second, "maximum time spent serving a request; 0 disables it")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	bus := eventbus.New()
+	var background sync.WaitGroup
+
+	codec, err := cursorCodec(*cursorSecret)
 	if err != nil {
-		panic(err)
+		log.Fatalf("cursor secret unavailable: %v", err)
+	}
+
+	// The SQL gateways write events to the outbox, and the relay forwards them
+	// to the bus, so the use cases must not publish them a second time. They
+	// also write every change to the changes table in the same transaction,
+	// which GET /changes and the change file then follow; in memory, the
+	// gateways are wrapped to append their changes to a cdc.Log instead.
+	var (
+		categoryGateway   category.CategoryGateway
+		castMemberGateway castmember.CastMemberGateway
+		publisher         event.Publisher
+		changes           cdc.Feed
+	)
+	if *dbDSN != "" {
+		db, err := database.Open(*dbDriver, *dbDSN)
+		if err != nil {
+			log.Fatalf("database unavailable: %v", err)
+		}
+		defer db.Close()
+		categoryGateway = database.NewCategoryGateway(db)
+		castMemberGateway = database.NewCastMemberGateway(db)
+		publisher = event.Discard
+		changes = database.NewChangeFeed(db)
+
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			database.NewRelay(db, database.ForwardTo(bus)).Run(ctx)
+		}()
+		if *changesFile != "" {
+			written, err := cdc.ReadNDJSONFile(*changesFile, 1)
+			if err != nil {
+				log.Fatalf("change file unreadable: %v", err)
+			}
+			var offset uint64
+			if len(written) > 0 {
+				offset = written[0].Offset
+			}
+			sink, err := cdc.OpenNDJSONFile(*changesFile)
+			if err != nil {
+				log.Fatalf("change file unavailable: %v", err)
+			}
+			defer sink.Close()
+
+			background.Add(1)
+			go func() {
+				defer background.Done()
+				cdc.Follow(ctx, changes, sink, offset, time.Second)
+			}()
+		}
+	} else {
+		changeOptions := []cdc.LogOption{cdc.WithRetention(*changesRetention)}
+		if *changesFile != "" {
+			history, err := cdc.ReadNDJSONFile(*changesFile, *changesRetention)
+			if err != nil {
+				log.Fatalf("change file unreadable: %v", err)
+			}
+			sink, err := cdc.OpenNDJSONFile(*changesFile)
+			if err != nil {
+				log.Fatalf("change file unavailable: %v", err)
+			}
+			defer sink.Close()
+			changeOptions = append(changeOptions, cdc.WithHistory(history), cdc.WithSinks(sink))
+		}
+		changeLog := cdc.NewLog(changeOptions...)
+		categoryGateway = cdc.NewCategoryGateway(memory.NewCategoryGateway(), changeLog)
+		castMemberGateway = cdc.NewCastMemberGateway(memory.NewCastMemberGateway(), changeLog)
+		publisher = bus
+		changes = changeLog
+	}
+
+	router := api.WithTimeout(api.NewRouter(
+		api.NewCategoryHandler(categoryGateway, publisher, codec),
+		api.NewCastMemberHandler(castMemberGateway, publisher, codec),
+		api.NewChangeHandler(changes),
+	), *requestTimeout)
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           router,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      15 * time.Second,
+		IdleTimeout:       60 * time.Second,
+	}
+
+	go func() {
+		log.Printf("admin catalog API listening on %s", *addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("server failed: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("graceful shutdown failed: %v", err)
+	}
+	background.Wait()
+	if err := bus.Close(shutdownCtx); err != nil {
+		log.Printf("event bus did not drain: %v", err)
+	}
+}
+
+func cursorCodec(secret string) (*pagination.CursorCodec, error) {
+	if secret != "" {
+		return pagination.NewCursorCodec([]byte(secret)), nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
 	}
-	fmt.Printf("Category created: %+v\n", c)
+	return pagination.NewCursorCodec(key), nil
 }
@@ -0,0 +1,71 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+type NotFoundError struct {
+	Resource string
+	ID       string
+	Err      error
+}
+
+func NewNotFoundError(resource, id string, err error) NotFoundError {
+	return NotFoundError{Resource: resource, ID: id, Err: err}
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s with id '%s' was not found", e.Resource, e.ID)
+}
+
+func (e NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+type ValidationError struct {
+	Errors []validation.FieldError
+	Err    error
+}
+
+func NewValidationError(notification *validation.Notification) ValidationError {
+	return ValidationError{Errors: notification.Errors(), Err: notification}
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("validation failed: %v", e.Err)
+}
+
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+type ConflictError struct {
+	Resource string
+	ID       string
+	Err      error
+}
+
+func NewConflictError(resource, id string, err error) ConflictError {
+	return ConflictError{Resource: resource, ID: id, Err: err}
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("%s with id '%s' already exists", e.Resource, e.ID)
+}
+
+func (e ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// FromValidation converts a domain validation Notification into a
+// ValidationError, leaving any other error untouched.
+func FromValidation(err error) error {
+	var notification *validation.Notification
+	if errors.As(err, &notification) {
+		return NewValidationError(notification)
+	}
+	return err
+}
@@ -0,0 +1,45 @@
+package apperror_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+func TestGivenANotification_WhenCallFromValidation_ThenShouldReturnAValidationError(t *testing.T) {
+	notification := validation.NewNotification().
+		Append("name", validation.CodeRequired, "'name' should not be empty").
+		Append("type", validation.CodeInvalid, "'type' must be either 'ACTOR' or 'DIRECTOR'")
+
+	err := apperror.FromValidation(notification.Err())
+
+	var validationErr apperror.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, notification.Errors(), validationErr.Errors)
+	assert.Equal(t,
+		"validation failed: 'name' should not be empty; 'type' must be either 'ACTOR' or 'DIRECTOR'",
+		err.Error(),
+	)
+}
+
+func TestGivenAnyOtherError_WhenCallFromValidation_ThenShouldReturnItUntouched(t *testing.T) {
+	expectedErr := errors.New("boom")
+
+	assert.Equal(t, expectedErr, apperror.FromValidation(expectedErr))
+	assert.Nil(t, apperror.FromValidation(nil))
+}
+
+func TestGivenNotFoundAndConflictErrors_WhenCallErrorsIs_ThenShouldUnwrapTheCause(t *testing.T) {
+	cause := errors.New("cause")
+
+	notFound := apperror.NewNotFoundError("category", "123", cause)
+	conflict := apperror.NewConflictError("category", "123", cause)
+
+	assert.Equal(t, "category with id '123' was not found", notFound.Error())
+	assert.Equal(t, "category with id '123' already exists", conflict.Error())
+	assert.ErrorIs(t, notFound, cause)
+	assert.ErrorIs(t, conflict, cause)
+}
@@ -0,0 +1,60 @@
+package castmemberusecase_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type MockCastMemberGateway struct {
+	mock.Mock
+}
+
+var _ castmember.CastMemberGateway = (*MockCastMemberGateway)(nil)
+
+func (m *MockCastMemberGateway) Create(ctx context.Context, c *castmember.CastMember) (*castmember.CastMember, error) {
+	args := m.Called(ctx, c)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*castmember.CastMember), nil
+}
+
+func (m *MockCastMemberGateway) Update(ctx context.Context, c *castmember.CastMember) (*castmember.CastMember, error) {
+	args := m.Called(ctx, c)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*castmember.CastMember), nil
+}
+
+func (m *MockCastMemberGateway) DeleteByID(ctx context.Context, id castmember.CastMemberID, events ...event.Event) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCastMemberGateway) FindByID(ctx context.Context, id castmember.CastMemberID) (*castmember.CastMember, error) {
+	args := m.Called(ctx, id)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*castmember.CastMember), nil
+}
+
+func (m *MockCastMemberGateway) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[castmember.CastMember], error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Pagination[castmember.CastMember]), nil
+}
+
+func (m *MockCastMemberGateway) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[castmember.CastMember], error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.CursorPage[castmember.CastMember]), nil
+}
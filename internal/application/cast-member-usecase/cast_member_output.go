@@ -0,0 +1,58 @@
+package castmemberusecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+)
+
+const resourceName = "cast member"
+
+type CastMemberOutput struct {
+	ID        string
+	Name      string
+	Type      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewCastMemberOutput(c castmember.CastMember) CastMemberOutput {
+	return CastMemberOutput{
+		ID:        c.ID.String(),
+		Name:      c.Name,
+		Type:      string(c.Type),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toCastMemberType(value string) castmember.CastMemberType {
+	return castmember.CastMemberType(strings.ToUpper(strings.TrimSpace(value)))
+}
+
+func findCastMember(ctx context.Context, gateway castmember.CastMemberGateway, id string) (*castmember.CastMember, error) {
+	castMemberID, err := castmember.ParseCastMemberID(id)
+	if err != nil {
+		return nil, apperror.NewNotFoundError(resourceName, id, err)
+	}
+	c, err := gateway.FindByID(ctx, castMemberID)
+	if err != nil {
+		return nil, mapError(err, id)
+	}
+	return c, nil
+}
+
+func mapError(err error, id string) error {
+	switch {
+	case errors.Is(err, castmember.ErrCastMemberNotFound):
+		return apperror.NewNotFoundError(resourceName, id, err)
+	case errors.Is(err, castmember.ErrCastMemberAlreadyExists):
+		return apperror.NewConflictError(resourceName, id, err)
+	default:
+		return apperror.FromValidation(err)
+	}
+}
@@ -0,0 +1,46 @@
+package castmemberusecase
+
+import (
+	"context"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type CreateCastMemberInput struct {
+	Name string
+	Type string
+}
+
+type CreateCastMemberUseCase struct {
+	gateway   castmember.CastMemberGateway
+	publisher event.Publisher
+	clock     timeutils.Clock
+}
+
+func NewCreateCastMemberUseCase(gateway castmember.CastMemberGateway, publisher event.Publisher) *CreateCastMemberUseCase {
+	return &CreateCastMemberUseCase{gateway: gateway, publisher: publisher}
+}
+
+// WithClock replaces the system clock as the source of new cast members' timestamps.
+func (u *CreateCastMemberUseCase) WithClock(clock timeutils.Clock) *CreateCastMemberUseCase {
+	u.clock = clock
+	return u
+}
+
+func (u *CreateCastMemberUseCase) Execute(ctx context.Context, input CreateCastMemberInput) (*CastMemberOutput, error) {
+	c, err := castmember.NewCastMember(u.clock, input.Name, toCastMemberType(input.Type))
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+
+	created, err := u.gateway.Create(ctx, c)
+	if err != nil {
+		return nil, mapError(err, c.ID.String())
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+
+	output := NewCastMemberOutput(*created)
+	return &output, nil
+}
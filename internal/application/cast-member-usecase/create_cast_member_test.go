@@ -0,0 +1,59 @@
+package castmemberusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAValidInput_WhenCallCreateCastMember_ThenShouldPersistAndReturnIt(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	useCase := castmemberusecase.NewCreateCastMemberUseCase(gateway, eventtest.NewRecorder())
+
+	output, err := useCase.Execute(t.Context(), castmemberusecase.CreateCastMemberInput{Name: "Vin Diesel", Type: "actor"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.ID)
+	assert.Equal(t, "Vin Diesel", output.Name)
+	assert.Equal(t, "ACTOR", output.Type)
+
+	id, err := castmember.ParseCastMemberID(output.ID)
+	require.NoError(t, err)
+	persisted, err := gateway.FindByID(t.Context(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, castmember.Actor, persisted.Type)
+}
+
+func TestGivenAnInvalidNameAndType_WhenCallCreateCastMember_ThenShouldListEveryInvalidField(t *testing.T) {
+	useCase := castmemberusecase.NewCreateCastMemberUseCase(memory.NewCastMemberGateway(), eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), castmemberusecase.CreateCastMemberInput{Name: "", Type: "PRODUCER"})
+
+	var validationErr apperror.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, []validation.FieldError{
+		{Field: "name", Code: validation.CodeRequired, Message: "'name' should not be empty"},
+		{Field: "type", Code: validation.CodeInvalid, Message: "'type' must be either 'ACTOR' or 'DIRECTOR'"},
+	}, validationErr.Errors)
+}
+
+func TestGivenADuplicatedCastMember_WhenCallCreateCastMember_ThenShouldReceiveAConflictError(t *testing.T) {
+	gateway := new(MockCastMemberGateway)
+	gateway.On("Create", mock.Anything, mock.Anything).Return(nil, castmember.ErrCastMemberAlreadyExists)
+	useCase := castmemberusecase.NewCreateCastMemberUseCase(gateway, eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), castmemberusecase.CreateCastMemberInput{Name: "Vin Diesel", Type: "ACTOR"})
+
+	var conflictErr apperror.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	gateway.AssertExpectations(t)
+}
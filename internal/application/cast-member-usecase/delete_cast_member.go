@@ -0,0 +1,39 @@
+package castmemberusecase
+
+import (
+	"context"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type DeleteCastMemberUseCase struct {
+	gateway   castmember.CastMemberGateway
+	publisher event.Publisher
+	clock     timeutils.Clock
+}
+
+func NewDeleteCastMemberUseCase(gateway castmember.CastMemberGateway, publisher event.Publisher) *DeleteCastMemberUseCase {
+	return &DeleteCastMemberUseCase{gateway: gateway, publisher: publisher}
+}
+
+// WithClock replaces the system clock CastMemberDeleted is stamped with.
+func (u *DeleteCastMemberUseCase) WithClock(clock timeutils.Clock) *DeleteCastMemberUseCase {
+	u.clock = clock
+	return u
+}
+
+func (u *DeleteCastMemberUseCase) Execute(ctx context.Context, id string) error {
+	c, err := findCastMember(ctx, u.gateway, id)
+	if err != nil {
+		return err
+	}
+
+	c.Delete(u.clock)
+	if err := u.gateway.DeleteByID(ctx, c.ID, c.PendingEvents()...); err != nil {
+		return mapError(err, id)
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+	return nil
+}
@@ -0,0 +1,46 @@
+package castmemberusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAnExistingCastMember_WhenCallDeleteCastMember_ThenShouldRemoveIt(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	existing := givenAPersistedCastMember(t, gateway)
+	useCase := castmemberusecase.NewDeleteCastMemberUseCase(gateway, eventtest.NewRecorder())
+
+	assert.NoError(t, useCase.Execute(t.Context(), existing.ID.String()))
+
+	_, err := gateway.FindByID(t.Context(), existing.ID)
+	assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+}
+
+func TestGivenAnUnknownID_WhenCallDeleteCastMember_ThenShouldReceiveANotFoundError(t *testing.T) {
+	useCase := castmemberusecase.NewDeleteCastMemberUseCase(memory.NewCastMemberGateway(), eventtest.NewRecorder())
+
+	err := useCase.Execute(t.Context(), "not-a-uuid")
+
+	var notFoundErr apperror.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, "cast member", notFoundErr.Resource)
+}
+
+func TestGivenAnExistingCastMember_WhenCallDeleteCastMember_ThenShouldPublishCastMemberDeleted(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	existing := givenAPersistedCastMember(t, gateway)
+	publisher := eventtest.NewRecorder()
+	useCase := castmemberusecase.NewDeleteCastMemberUseCase(gateway, publisher)
+
+	assert.NoError(t, useCase.Execute(t.Context(), existing.ID.String()))
+
+	assert.Equal(t, []string{castmember.CastMemberDeletedEvent}, publisher.Names())
+	assert.Equal(t, existing.ID.String(), publisher.Events()[0].AggregateID())
+}
@@ -0,0 +1,25 @@
+package castmemberusecase
+
+import (
+	"context"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+)
+
+type GetCastMemberByIDUseCase struct {
+	gateway castmember.CastMemberGateway
+}
+
+func NewGetCastMemberByIDUseCase(gateway castmember.CastMemberGateway) *GetCastMemberByIDUseCase {
+	return &GetCastMemberByIDUseCase{gateway: gateway}
+}
+
+func (u *GetCastMemberByIDUseCase) Execute(ctx context.Context, id string) (*CastMemberOutput, error) {
+	c, err := findCastMember(ctx, u.gateway, id)
+	if err != nil {
+		return nil, err
+	}
+
+	output := NewCastMemberOutput(*c)
+	return &output, nil
+}
@@ -0,0 +1,39 @@
+package castmemberusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAnExistingCastMember_WhenCallGetCastMemberByID_ThenShouldReturnIt(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	existing := givenAPersistedCastMember(t, gateway)
+	useCase := castmemberusecase.NewGetCastMemberByIDUseCase(gateway)
+
+	output, err := useCase.Execute(t.Context(), existing.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, castmemberusecase.NewCastMemberOutput(*existing), *output)
+}
+
+func TestGivenAMissingCastMember_WhenCallGetCastMemberByID_ThenShouldReceiveANotFoundError(t *testing.T) {
+	id := castmember.NewCastMemberID()
+	gateway := new(MockCastMemberGateway)
+	gateway.On("FindByID", mock.Anything, id).Return(nil, castmember.ErrCastMemberNotFound)
+	useCase := castmemberusecase.NewGetCastMemberByIDUseCase(gateway)
+
+	_, err := useCase.Execute(t.Context(), id.String())
+
+	var notFoundErr apperror.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, id.String(), notFoundErr.ID)
+	gateway.AssertExpectations(t)
+}
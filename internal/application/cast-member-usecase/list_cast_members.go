@@ -0,0 +1,59 @@
+package castmemberusecase
+
+import (
+	"context"
+	"maps"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+type ListCastMembersUseCase struct {
+	gateway castmember.CastMemberGateway
+	rules   pagination.SearchRules
+}
+
+func NewListCastMembersUseCase(gateway castmember.CastMemberGateway) *ListCastMembersUseCase {
+	return &ListCastMembersUseCase{gateway: gateway, rules: castmember.SearchRules}
+}
+
+// WithMaxPerPage caps how many cast members a single page may hold.
+func (u *ListCastMembersUseCase) WithMaxPerPage(maxPerPage int) *ListCastMembersUseCase {
+	u.rules.MaxPerPage = maxPerPage
+	return u
+}
+
+func (u *ListCastMembersUseCase) Execute(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[CastMemberOutput], error) {
+	query, err := query.Normalize(u.rules)
+	if err != nil {
+		return nil, apperror.FromValidation(err)
+	}
+	if query.Filters, err = typeFilter(query.Filters); err != nil {
+		return nil, err
+	}
+
+	result, err := u.gateway.FindAll(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+	return pagination.MapItems(result, NewCastMemberOutput), nil
+}
+
+// typeFilter checks the type a listing is filtered by and spells it the way
+// the gateways store it.
+func typeFilter(filters map[string]string) (map[string]string, error) {
+	value, ok := filters["type"]
+	if !ok {
+		return filters, nil
+	}
+	castMemberType := toCastMemberType(value)
+	if castMemberType != castmember.Actor && castMemberType != castmember.Director {
+		return nil, apperror.NewValidationError(validation.NewNotification().
+			Append("type", validation.CodeInvalid, "'type' must be either 'ACTOR' or 'DIRECTOR'"))
+	}
+	filters = maps.Clone(filters)
+	filters["type"] = string(castMemberType)
+	return filters, nil
+}
@@ -0,0 +1,41 @@
+package castmemberusecase
+
+import (
+	"context"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type ListCastMembersByCursorUseCase struct {
+	gateway castmember.CastMemberGateway
+	codec   *pagination.CursorCodec
+	rules   pagination.SearchRules
+}
+
+func NewListCastMembersByCursorUseCase(gateway castmember.CastMemberGateway, codec *pagination.CursorCodec) *ListCastMembersByCursorUseCase {
+	return &ListCastMembersByCursorUseCase{gateway: gateway, codec: codec, rules: castmember.SearchRules}
+}
+
+// WithMaxPerPage caps how many cast members a single page may hold.
+func (u *ListCastMembersByCursorUseCase) WithMaxPerPage(maxPerPage int) *ListCastMembersByCursorUseCase {
+	u.rules.MaxPerPage = maxPerPage
+	return u
+}
+
+func (u *ListCastMembersByCursorUseCase) Execute(ctx context.Context, request pagination.CursorRequest) (*pagination.TokenPage[CastMemberOutput], error) {
+	query, err := request.Query(u.codec, u.rules)
+	if err != nil {
+		return nil, apperror.FromValidation(err)
+	}
+	if query.Filters, err = typeFilter(query.Filters); err != nil {
+		return nil, err
+	}
+
+	result, err := u.gateway.FindAllByCursor(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+	return pagination.EncodePage(u.codec, result, NewCastMemberOutput), nil
+}
@@ -0,0 +1,56 @@
+package castmemberusecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenATypeFilter_WhenCallListCastMembersByCursor_ThenShouldPageTheMatchesWithTokens(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	for _, c := range []struct {
+		name           string
+		castMemberType castmember.CastMemberType
+	}{
+		{"Vin Diesel", castmember.Actor},
+		{"Greta Gerwig", castmember.Director},
+		{"Keanu Reeves", castmember.Actor},
+		{"Al Pacino", castmember.Actor},
+	} {
+		member, err := castmember.NewCastMember(nil, c.name, c.castMemberType)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), member)
+		require.NoError(t, err)
+	}
+	useCase := castmemberusecase.NewListCastMembersByCursorUseCase(gateway, pagination.NewCursorCodec([]byte("secret")))
+	request := pagination.CursorRequest{Size: 2, Filters: map[string]string{"type": "actor"}}
+
+	first, err := useCase.Execute(t.Context(), request)
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "Al Pacino", first.Items[0].Name)
+	assert.Equal(t, "Keanu Reeves", first.Items[1].Name)
+
+	request.After = first.Next
+	second, err := useCase.Execute(t.Context(), request)
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "Vin Diesel", second.Items[0].Name)
+	assert.Empty(t, second.Next)
+}
+
+func TestGivenAnUnknownType_WhenCallListCastMembersByCursor_ThenShouldReturnAValidationError(t *testing.T) {
+	useCase := castmemberusecase.NewListCastMembersByCursorUseCase(memory.NewCastMemberGateway(), pagination.NewCursorCodec([]byte("secret")))
+
+	_, err := useCase.Execute(t.Context(), pagination.CursorRequest{Filters: map[string]string{"type": "writer"}})
+
+	var validationErr apperror.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "'type' must be either 'ACTOR' or 'DIRECTOR'")
+}
@@ -0,0 +1,79 @@
+package castmemberusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenPersistedCastMembers_WhenCallListCastMembers_ThenShouldReturnAPageOfOutputs(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	for _, name := range []string{"Vin Diesel", "Keanu Reeves", "Greta Gerwig"} {
+		c, err := castmember.NewCastMember(nil, name, castmember.Actor)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+	useCase := castmemberusecase.NewListCastMembersUseCase(gateway)
+
+	output, err := useCase.Execute(t.Context(), pagination.SearchQuery{PerPage: 2, Terms: "e", Sort: "name", Direction: "desc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), output.Total)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, "Vin Diesel", output.Items[0].Name)
+	assert.Equal(t, "Keanu Reeves", output.Items[1].Name)
+	assert.Equal(t, "ACTOR", output.Items[0].Type)
+}
+
+func TestGivenATypeFilter_WhenCallListCastMembers_ThenShouldNormalizeAndApplyIt(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	for _, c := range []struct {
+		name           string
+		castMemberType castmember.CastMemberType
+	}{
+		{"Vin Diesel", castmember.Actor},
+		{"Greta Gerwig", castmember.Director},
+	} {
+		entity, err := castmember.NewCastMember(nil, c.name, c.castMemberType)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), entity)
+		require.NoError(t, err)
+	}
+	useCase := castmemberusecase.NewListCastMembersUseCase(gateway)
+	filters := map[string]string{"type": " director "}
+
+	output, err := useCase.Execute(t.Context(), pagination.SearchQuery{PerPage: 10, Filters: filters})
+
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	assert.Equal(t, "Greta Gerwig", output.Items[0].Name)
+	assert.Equal(t, " director ", filters["type"])
+}
+
+func TestGivenAnInvalidTypeFilter_WhenCallListCastMembers_ThenShouldReceiveAValidationError(t *testing.T) {
+	useCase := castmemberusecase.NewListCastMembersUseCase(memory.NewCastMemberGateway())
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{PerPage: 10, Filters: map[string]string{"type": "PRODUCER"}})
+
+	var validationErr apperror.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "type", validationErr.Errors[0].Field)
+}
+
+func TestGivenAnUnsupportedSort_WhenCallListCastMembers_ThenShouldReceiveAValidationError(t *testing.T) {
+	useCase := castmemberusecase.NewListCastMembersUseCase(memory.NewCastMemberGateway())
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{Sort: "updated_at"})
+
+	var validationErr apperror.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "sort", validationErr.Errors[0].Field)
+}
@@ -0,0 +1,51 @@
+package castmemberusecase
+
+import (
+	"context"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type UpdateCastMemberInput struct {
+	ID   string
+	Name string
+	Type string
+}
+
+type UpdateCastMemberUseCase struct {
+	gateway   castmember.CastMemberGateway
+	publisher event.Publisher
+	clock     timeutils.Clock
+}
+
+func NewUpdateCastMemberUseCase(gateway castmember.CastMemberGateway, publisher event.Publisher) *UpdateCastMemberUseCase {
+	return &UpdateCastMemberUseCase{gateway: gateway, publisher: publisher}
+}
+
+// WithClock replaces the system clock the update is stamped with.
+func (u *UpdateCastMemberUseCase) WithClock(clock timeutils.Clock) *UpdateCastMemberUseCase {
+	u.clock = clock
+	return u
+}
+
+func (u *UpdateCastMemberUseCase) Execute(ctx context.Context, input UpdateCastMemberInput) (*CastMemberOutput, error) {
+	c, err := findCastMember(ctx, u.gateway, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Update(u.clock, input.Name, toCastMemberType(input.Type)); err != nil {
+		return nil, mapError(err, input.ID)
+	}
+
+	updated, err := u.gateway.Update(ctx, c)
+	if err != nil {
+		return nil, mapError(err, input.ID)
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+
+	output := NewCastMemberOutput(*updated)
+	return &output, nil
+}
@@ -0,0 +1,72 @@
+package castmemberusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func givenAPersistedCastMember(t *testing.T, gateway castmember.CastMemberGateway) *castmember.CastMember {
+	t.Helper()
+	c, err := castmember.NewCastMember(nil, "Vin Diesel", castmember.Actor)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	return c
+}
+
+func TestGivenAValidInput_WhenCallUpdateCastMember_ThenShouldPersistChanges(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	existing := givenAPersistedCastMember(t, gateway)
+	useCase := castmemberusecase.NewUpdateCastMemberUseCase(gateway, eventtest.NewRecorder())
+
+	output, err := useCase.Execute(t.Context(), castmemberusecase.UpdateCastMemberInput{
+		ID:   existing.ID.String(),
+		Name: "Quentin Tarantino",
+		Type: "DIRECTOR",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Quentin Tarantino", output.Name)
+	assert.Equal(t, "DIRECTOR", output.Type)
+
+	persisted, err := gateway.FindByID(t.Context(), existing.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, castmember.Director, persisted.Type)
+}
+
+func TestGivenAnInvalidType_WhenCallUpdateCastMember_ThenShouldReceiveAValidationError(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+	existing := givenAPersistedCastMember(t, gateway)
+	useCase := castmemberusecase.NewUpdateCastMemberUseCase(gateway, eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), castmemberusecase.UpdateCastMemberInput{
+		ID:   existing.ID.String(),
+		Name: "Vin Diesel",
+		Type: "",
+	})
+
+	var validationErr apperror.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "type", validationErr.Errors[0].Field)
+}
+
+func TestGivenAnUnknownID_WhenCallUpdateCastMember_ThenShouldReceiveANotFoundError(t *testing.T) {
+	useCase := castmemberusecase.NewUpdateCastMemberUseCase(memory.NewCastMemberGateway(), eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), castmemberusecase.UpdateCastMemberInput{
+		ID:   castmember.NewCastMemberID().String(),
+		Name: "Vin Diesel",
+		Type: "ACTOR",
+	})
+
+	var notFoundErr apperror.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
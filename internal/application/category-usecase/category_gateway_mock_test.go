@@ -0,0 +1,60 @@
+package categoryusecase_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/mock"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type MockCategoryGateway struct {
+	mock.Mock
+}
+
+var _ category.CategoryGateway = (*MockCategoryGateway)(nil)
+
+func (m *MockCategoryGateway) Create(ctx context.Context, c *category.Category) (*category.Category, error) {
+	args := m.Called(ctx, c)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*category.Category), nil
+}
+
+func (m *MockCategoryGateway) Update(ctx context.Context, c *category.Category) (*category.Category, error) {
+	args := m.Called(ctx, c)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*category.Category), nil
+}
+
+func (m *MockCategoryGateway) DeleteByID(ctx context.Context, id category.CategoryID, events ...event.Event) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockCategoryGateway) FindByID(ctx context.Context, id category.CategoryID) (*category.Category, error) {
+	args := m.Called(ctx, id)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*category.Category), nil
+}
+
+func (m *MockCategoryGateway) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[category.Category], error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Pagination[category.Category]), nil
+}
+
+func (m *MockCategoryGateway) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[category.Category], error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.CursorPage[category.Category]), nil
+}
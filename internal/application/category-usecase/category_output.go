@@ -0,0 +1,57 @@
+package categoryusecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+)
+
+const resourceName = "category"
+
+type CategoryOutput struct {
+	ID          string
+	Name        string
+	Description string
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+func NewCategoryOutput(c category.Category) CategoryOutput {
+	return CategoryOutput{
+		ID:          c.ID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		IsActive:    c.Active,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+		DeletedAt:   c.DeletedAt,
+	}
+}
+
+func findCategory(ctx context.Context, gateway category.CategoryGateway, id string) (*category.Category, error) {
+	categoryID, err := category.ParseCategoryID(id)
+	if err != nil {
+		return nil, apperror.NewNotFoundError(resourceName, id, err)
+	}
+	c, err := gateway.FindByID(ctx, categoryID)
+	if err != nil {
+		return nil, mapError(err, id)
+	}
+	return c, nil
+}
+
+func mapError(err error, id string) error {
+	switch {
+	case errors.Is(err, category.ErrCategoryNotFound):
+		return apperror.NewNotFoundError(resourceName, id, err)
+	case errors.Is(err, category.ErrCategoryAlreadyExists):
+		return apperror.NewConflictError(resourceName, id, err)
+	default:
+		return apperror.FromValidation(err)
+	}
+}
@@ -0,0 +1,47 @@
+package categoryusecase
+
+import (
+	"context"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type CreateCategoryInput struct {
+	Name        string
+	Description string
+	IsActive    bool
+}
+
+type CreateCategoryUseCase struct {
+	gateway   category.CategoryGateway
+	publisher event.Publisher
+	clock     timeutils.Clock
+}
+
+func NewCreateCategoryUseCase(gateway category.CategoryGateway, publisher event.Publisher) *CreateCategoryUseCase {
+	return &CreateCategoryUseCase{gateway: gateway, publisher: publisher}
+}
+
+// WithClock replaces the system clock as the source of new categories' timestamps.
+func (u *CreateCategoryUseCase) WithClock(clock timeutils.Clock) *CreateCategoryUseCase {
+	u.clock = clock
+	return u
+}
+
+func (u *CreateCategoryUseCase) Execute(ctx context.Context, input CreateCategoryInput) (*CategoryOutput, error) {
+	c, err := category.NewCategory(u.clock, input.Name, input.Description, input.IsActive)
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+
+	created, err := u.gateway.Create(ctx, c)
+	if err != nil {
+		return nil, mapError(err, c.ID.String())
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+
+	output := NewCategoryOutput(*created)
+	return &output, nil
+}
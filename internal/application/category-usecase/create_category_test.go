@@ -0,0 +1,113 @@
+package categoryusecase_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+func TestGivenAValidInput_WhenCallCreateCategory_ThenShouldPersistAndReturnIt(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	useCase := categoryusecase.NewCreateCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	output, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{
+		Name:        "Filmes",
+		Description: "A categoria mais assistida",
+		IsActive:    true,
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.ID)
+	assert.Equal(t, "Filmes", output.Name)
+	assert.Equal(t, "A categoria mais assistida", output.Description)
+	assert.True(t, output.IsActive)
+	assert.Nil(t, output.DeletedAt)
+
+	id, err := category.ParseCategoryID(output.ID)
+	require.NoError(t, err)
+	persisted, err := gateway.FindByID(t.Context(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "Filmes", persisted.Name)
+}
+
+func TestGivenAnInvalidInput_WhenCallCreateCategory_ThenShouldReceiveAValidationError(t *testing.T) {
+	useCase := categoryusecase.NewCreateCategoryUseCase(memory.NewCategoryGateway(), eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "ab"})
+
+	var validationErr apperror.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Len(t, validationErr.Errors, 1)
+	assert.Equal(t, "name", validationErr.Errors[0].Field)
+}
+
+func TestGivenADuplicatedCategory_WhenCallCreateCategory_ThenShouldReceiveAConflictError(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	gateway.On("Create", mock.Anything, mock.Anything).Return(nil, category.ErrCategoryAlreadyExists)
+	useCase := categoryusecase.NewCreateCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	var conflictErr apperror.ConflictError
+	assert.True(t, errors.As(err, &conflictErr))
+	assert.ErrorIs(t, err, category.ErrCategoryAlreadyExists)
+	gateway.AssertExpectations(t)
+}
+
+func TestGivenAGatewayFailure_WhenCallCreateCategory_ThenShouldReturnTheError(t *testing.T) {
+	expectedErr := errors.New("gateway error")
+	gateway := new(MockCategoryGateway)
+	gateway.On("Create", mock.Anything, mock.Anything).Return(nil, expectedErr)
+	useCase := categoryusecase.NewCreateCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGivenAValidInput_WhenCallCreateCategory_ThenShouldPublishCategoryCreated(t *testing.T) {
+	publisher := eventtest.NewRecorder()
+	useCase := categoryusecase.NewCreateCategoryUseCase(memory.NewCategoryGateway(), publisher)
+
+	output, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	require.NoError(t, err)
+	published := eventtest.Of[category.CategoryCreated](publisher)
+	require.Len(t, published, 1)
+	assert.Equal(t, output.ID, published[0].AggregateID())
+	assert.Equal(t, "Filmes", published[0].Name)
+}
+
+func TestGivenAFailedSave_WhenCallCreateCategory_ThenShouldPublishNothing(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	gateway.On("Create", mock.Anything, mock.Anything).Return(nil, category.ErrCategoryAlreadyExists)
+	publisher := eventtest.NewRecorder()
+	useCase := categoryusecase.NewCreateCategoryUseCase(gateway, publisher)
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	assert.Error(t, err)
+	assert.Empty(t, publisher.Events())
+}
+
+func TestGivenAClock_WhenCallCreateCategory_ThenShouldStampTheCategoryWithIt(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	useCase := categoryusecase.NewCreateCategoryUseCase(memory.NewCategoryGateway(), eventtest.NewRecorder()).
+		WithClock(timeutils.NewFakeClock(now))
+
+	output, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, now, output.CreatedAt)
+	assert.Equal(t, now, output.UpdatedAt)
+}
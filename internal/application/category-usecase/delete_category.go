@@ -0,0 +1,30 @@
+package categoryusecase
+
+import (
+	"context"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+)
+
+type DeleteCategoryUseCase struct {
+	gateway   category.CategoryGateway
+	publisher event.Publisher
+}
+
+func NewDeleteCategoryUseCase(gateway category.CategoryGateway, publisher event.Publisher) *DeleteCategoryUseCase {
+	return &DeleteCategoryUseCase{gateway: gateway, publisher: publisher}
+}
+
+func (u *DeleteCategoryUseCase) Execute(ctx context.Context, id string) error {
+	c, err := findCategory(ctx, u.gateway, id)
+	if err != nil {
+		return err
+	}
+
+	if err := u.gateway.DeleteByID(ctx, c.ID); err != nil {
+		return mapError(err, id)
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+	return nil
+}
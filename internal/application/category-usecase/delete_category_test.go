@@ -0,0 +1,50 @@
+package categoryusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAnExistingCategory_WhenCallDeleteCategory_ThenShouldRemoveIt(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	existing := givenAPersistedCategory(t, gateway)
+	useCase := categoryusecase.NewDeleteCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	err := useCase.Execute(t.Context(), existing.ID.String())
+
+	assert.NoError(t, err)
+	_, err = gateway.FindByID(t.Context(), existing.ID)
+	assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+}
+
+func TestGivenAnUnknownID_WhenCallDeleteCategory_ThenShouldReceiveANotFoundError(t *testing.T) {
+	useCase := categoryusecase.NewDeleteCategoryUseCase(memory.NewCategoryGateway(), eventtest.NewRecorder())
+
+	err := useCase.Execute(t.Context(), category.NewCategoryID().String())
+
+	var notFoundErr apperror.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+}
+
+func TestGivenAGatewayFailure_WhenCallDeleteCategory_ThenShouldReturnTheError(t *testing.T) {
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	assert.NoError(t, err)
+	expectedErr := errors.New("gateway error")
+	gateway := new(MockCategoryGateway)
+	gateway.On("FindByID", mock.Anything, c.ID).Return(c, nil)
+	gateway.On("DeleteByID", mock.Anything, mock.Anything).Return(expectedErr)
+	useCase := categoryusecase.NewDeleteCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	err = useCase.Execute(t.Context(), c.ID.String())
+
+	assert.Equal(t, expectedErr, err)
+	gateway.AssertExpectations(t)
+}
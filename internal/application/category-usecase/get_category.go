@@ -0,0 +1,25 @@
+package categoryusecase
+
+import (
+	"context"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+)
+
+type GetCategoryByIDUseCase struct {
+	gateway category.CategoryGateway
+}
+
+func NewGetCategoryByIDUseCase(gateway category.CategoryGateway) *GetCategoryByIDUseCase {
+	return &GetCategoryByIDUseCase{gateway: gateway}
+}
+
+func (u *GetCategoryByIDUseCase) Execute(ctx context.Context, id string) (*CategoryOutput, error) {
+	c, err := findCategory(ctx, u.gateway, id)
+	if err != nil {
+		return nil, err
+	}
+
+	output := NewCategoryOutput(*c)
+	return &output, nil
+}
@@ -0,0 +1,37 @@
+package categoryusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAnExistingCategory_WhenCallGetCategoryByID_ThenShouldReturnIt(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	existing := givenAPersistedCategory(t, gateway)
+	useCase := categoryusecase.NewGetCategoryByIDUseCase(gateway)
+
+	output, err := useCase.Execute(t.Context(), existing.ID.String())
+
+	require.NoError(t, err)
+	assert.Equal(t, categoryusecase.NewCategoryOutput(*existing), *output)
+}
+
+func TestGivenAnUnknownID_WhenCallGetCategoryByID_ThenShouldReceiveANotFoundError(t *testing.T) {
+	useCase := categoryusecase.NewGetCategoryByIDUseCase(memory.NewCategoryGateway())
+	id := category.NewCategoryID().String()
+
+	_, err := useCase.Execute(t.Context(), id)
+
+	var notFoundErr apperror.NotFoundError
+	assert.True(t, errors.As(err, &notFoundErr))
+	assert.Equal(t, "category", notFoundErr.Resource)
+	assert.Equal(t, "category with id '"+id+"' was not found", err.Error())
+	assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+}
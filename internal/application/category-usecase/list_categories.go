@@ -0,0 +1,36 @@
+package categoryusecase
+
+import (
+	"context"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type ListCategoriesUseCase struct {
+	gateway category.CategoryGateway
+	rules   pagination.SearchRules
+}
+
+func NewListCategoriesUseCase(gateway category.CategoryGateway) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{gateway: gateway, rules: category.SearchRules}
+}
+
+// WithMaxPerPage caps how many categories a single page may hold.
+func (u *ListCategoriesUseCase) WithMaxPerPage(maxPerPage int) *ListCategoriesUseCase {
+	u.rules.MaxPerPage = maxPerPage
+	return u
+}
+
+func (u *ListCategoriesUseCase) Execute(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[CategoryOutput], error) {
+	query, err := query.Normalize(u.rules)
+	if err != nil {
+		return nil, apperror.FromValidation(err)
+	}
+
+	result, err := u.gateway.FindAll(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+	return pagination.MapItems(result, NewCategoryOutput), nil
+}
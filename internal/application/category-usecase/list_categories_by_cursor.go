@@ -0,0 +1,38 @@
+package categoryusecase
+
+import (
+	"context"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type ListCategoriesByCursorUseCase struct {
+	gateway category.CategoryGateway
+	codec   *pagination.CursorCodec
+	rules   pagination.SearchRules
+}
+
+func NewListCategoriesByCursorUseCase(gateway category.CategoryGateway, codec *pagination.CursorCodec) *ListCategoriesByCursorUseCase {
+	return &ListCategoriesByCursorUseCase{gateway: gateway, codec: codec, rules: category.SearchRules}
+}
+
+// WithMaxPerPage caps how many categories a single page may hold.
+func (u *ListCategoriesByCursorUseCase) WithMaxPerPage(maxPerPage int) *ListCategoriesByCursorUseCase {
+	u.rules.MaxPerPage = maxPerPage
+	return u
+}
+
+func (u *ListCategoriesByCursorUseCase) Execute(ctx context.Context, request pagination.CursorRequest) (*pagination.TokenPage[CategoryOutput], error) {
+	query, err := request.Query(u.codec, u.rules)
+	if err != nil {
+		return nil, apperror.FromValidation(err)
+	}
+
+	result, err := u.gateway.FindAllByCursor(ctx, query)
+	if err != nil {
+		return nil, mapError(err, "")
+	}
+	return pagination.EncodePage(u.codec, result, NewCategoryOutput), nil
+}
@@ -0,0 +1,56 @@
+package categoryusecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenPersistedCategories_WhenCallListCategoriesByCursor_ThenShouldWalkThePagesWithTokens(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	for _, name := range []string{"Filmes", "Series", "Documentarios"} {
+		c, err := category.NewCategory(nil, name, "", true)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+	useCase := categoryusecase.NewListCategoriesByCursorUseCase(gateway, pagination.NewCursorCodec([]byte("secret")))
+
+	first, err := useCase.Execute(t.Context(), pagination.CursorRequest{Size: 2})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 2)
+	assert.Equal(t, "Documentarios", first.Items[0].Name)
+	assert.Equal(t, "Filmes", first.Items[1].Name)
+	assert.Empty(t, first.Prev)
+	require.NotEmpty(t, first.Next)
+
+	second, err := useCase.Execute(t.Context(), pagination.CursorRequest{Size: 2, After: first.Next})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "Series", second.Items[0].Name)
+	assert.Empty(t, second.Next)
+
+	back, err := useCase.Execute(t.Context(), pagination.CursorRequest{Size: 2, Before: second.Prev})
+	require.NoError(t, err)
+	assert.Equal(t, first.Items, back.Items)
+}
+
+func TestGivenATokenSignedWithAnotherSecret_WhenCallListCategoriesByCursor_ThenShouldReturnAValidationError(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	useCase := categoryusecase.NewListCategoriesByCursorUseCase(gateway, pagination.NewCursorCodec([]byte("secret")))
+	forged := pagination.NewCursorCodec([]byte("other")).Encode(pagination.Cursor{Sort: "name", Direction: "asc", ID: "1"})
+
+	_, err := useCase.Execute(t.Context(), pagination.CursorRequest{After: forged})
+
+	var validationErr apperror.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "'cursor' is malformed or has been tampered with")
+	gateway.AssertNotCalled(t, "FindAllByCursor", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,96 @@
+package categoryusecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenPersistedCategories_WhenCallListCategories_ThenShouldReturnAPageOfOutputs(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	for _, name := range []string{"Filmes", "Series", "Documentarios"} {
+		c, err := category.NewCategory(nil, name, "", true)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+	useCase := categoryusecase.NewListCategoriesUseCase(gateway)
+
+	output, err := useCase.Execute(t.Context(), pagination.SearchQuery{Page: 0, PerPage: 2, Sort: "name", Direction: "asc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.CurrentPage)
+	assert.Equal(t, 2, output.PerPage)
+	assert.Equal(t, int64(3), output.Total)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, "Documentarios", output.Items[0].Name)
+	assert.Equal(t, "Filmes", output.Items[1].Name)
+}
+
+func TestGivenAGatewayFailure_WhenCallListCategories_ThenShouldReturnTheError(t *testing.T) {
+	expectedErr := errors.New("gateway error")
+	gateway := new(MockCategoryGateway)
+	gateway.On("FindAll", mock.Anything, pagination.SearchQuery{PerPage: 10, Sort: "name", Direction: "asc"}).Return(nil, expectedErr)
+	useCase := categoryusecase.NewListCategoriesUseCase(gateway)
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{})
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestGivenAnEmptyQuery_WhenCallListCategories_ThenShouldApplyDefaults(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	normalized := pagination.SearchQuery{PerPage: 10, Terms: "filmes", Sort: "name", Direction: "asc"}
+	gateway.On("FindAll", mock.Anything, normalized).Return(&pagination.Pagination[category.Category]{PerPage: 10}, nil)
+	useCase := categoryusecase.NewListCategoriesUseCase(gateway)
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{Terms: "  filmes "})
+
+	require.NoError(t, err)
+	gateway.AssertExpectations(t)
+}
+
+func TestGivenATooLargePerPage_WhenCallListCategories_ThenShouldClampToTheMax(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	normalized := pagination.SearchQuery{PerPage: 25, Sort: "created_at", Direction: "desc"}
+	gateway.On("FindAll", mock.Anything, normalized).Return(&pagination.Pagination[category.Category]{PerPage: 25}, nil)
+	useCase := categoryusecase.NewListCategoriesUseCase(gateway).WithMaxPerPage(25)
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{PerPage: 1000, Sort: "created_at", Direction: "DESC"})
+
+	require.NoError(t, err)
+	gateway.AssertExpectations(t)
+}
+
+func TestGivenAnInvalidSortAndDirection_WhenCallListCategories_ThenShouldReturnAValidationError(t *testing.T) {
+	gateway := new(MockCategoryGateway)
+	useCase := categoryusecase.NewListCategoriesUseCase(gateway)
+
+	_, err := useCase.Execute(t.Context(), pagination.SearchQuery{Sort: "description", Direction: "sideways"})
+
+	var validationErr apperror.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "'sort' must be one of 'name', 'created_at' or 'updated_at', got 'description'")
+	assert.Contains(t, err.Error(), "'direction' must be either 'asc' or 'desc', got 'sideways'")
+	gateway.AssertNotCalled(t, "FindAll", mock.Anything, mock.Anything)
+}
+
+func TestGivenACanceledContext_WhenCallListCategories_ThenShouldReturnTheContextError(t *testing.T) {
+	useCase := categoryusecase.NewListCategoriesUseCase(memory.NewCategoryGateway())
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := useCase.Execute(ctx, pagination.SearchQuery{})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorAs(t, err, new(apperror.ValidationError))
+}
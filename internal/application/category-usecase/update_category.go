@@ -0,0 +1,52 @@
+package categoryusecase
+
+import (
+	"context"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type UpdateCategoryInput struct {
+	ID          string
+	Name        string
+	Description string
+	IsActive    bool
+}
+
+type UpdateCategoryUseCase struct {
+	gateway   category.CategoryGateway
+	publisher event.Publisher
+	clock     timeutils.Clock
+}
+
+func NewUpdateCategoryUseCase(gateway category.CategoryGateway, publisher event.Publisher) *UpdateCategoryUseCase {
+	return &UpdateCategoryUseCase{gateway: gateway, publisher: publisher}
+}
+
+// WithClock replaces the system clock the update is stamped with.
+func (u *UpdateCategoryUseCase) WithClock(clock timeutils.Clock) *UpdateCategoryUseCase {
+	u.clock = clock
+	return u
+}
+
+func (u *UpdateCategoryUseCase) Execute(ctx context.Context, input UpdateCategoryInput) (*CategoryOutput, error) {
+	c, err := findCategory(ctx, u.gateway, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Update(u.clock, input.Name, input.Description, input.IsActive); err != nil {
+		return nil, mapError(err, input.ID)
+	}
+
+	updated, err := u.gateway.Update(ctx, c)
+	if err != nil {
+		return nil, mapError(err, input.ID)
+	}
+	u.publisher.Publish(ctx, c.PullEvents()...)
+
+	output := NewCategoryOutput(*updated)
+	return &output, nil
+}
@@ -0,0 +1,91 @@
+package categoryusecase_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func givenAPersistedCategory(t *testing.T, gateway category.CategoryGateway) *category.Category {
+	t.Helper()
+	c, err := category.NewCategory(nil, "Filmes", "A categoria mais assistida", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	return c
+}
+
+func TestGivenAValidInput_WhenCallUpdateCategory_ThenShouldPersistChanges(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	existing := givenAPersistedCategory(t, gateway)
+	useCase := categoryusecase.NewUpdateCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	output, err := useCase.Execute(t.Context(), categoryusecase.UpdateCategoryInput{
+		ID:          existing.ID.String(),
+		Name:        "Series",
+		Description: "Atualizada",
+		IsActive:    false,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID.String(), output.ID)
+	assert.Equal(t, "Series", output.Name)
+	assert.False(t, output.IsActive)
+	assert.NotNil(t, output.DeletedAt)
+
+	persisted, err := gateway.FindByID(t.Context(), existing.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Series", persisted.Name)
+}
+
+func TestGivenAnInvalidInput_WhenCallUpdateCategory_ThenShouldReceiveAValidationError(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	existing := givenAPersistedCategory(t, gateway)
+	useCase := categoryusecase.NewUpdateCategoryUseCase(gateway, eventtest.NewRecorder())
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.UpdateCategoryInput{ID: existing.ID.String(), Name: ""})
+
+	var validationErr apperror.ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+
+	persisted, err := gateway.FindByID(t.Context(), existing.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Filmes", persisted.Name)
+}
+
+func TestGivenAnUnknownID_WhenCallUpdateCategory_ThenShouldReceiveANotFoundError(t *testing.T) {
+	useCase := categoryusecase.NewUpdateCategoryUseCase(memory.NewCategoryGateway(), eventtest.NewRecorder())
+
+	for _, id := range []string{category.NewCategoryID().String(), "not-a-uuid"} {
+		_, err := useCase.Execute(t.Context(), categoryusecase.UpdateCategoryInput{ID: id, Name: "Series"})
+
+		var notFoundErr apperror.NotFoundError
+		assert.True(t, errors.As(err, &notFoundErr))
+		assert.Equal(t, id, notFoundErr.ID)
+	}
+}
+
+func TestGivenAValidInput_WhenCallUpdateCategory_ThenShouldPublishWhatChanged(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	existing := givenAPersistedCategory(t, gateway)
+	publisher := eventtest.NewRecorder()
+	useCase := categoryusecase.NewUpdateCategoryUseCase(gateway, publisher)
+
+	_, err := useCase.Execute(t.Context(), categoryusecase.UpdateCategoryInput{
+		ID:          existing.ID.String(),
+		Name:        "Series",
+		Description: existing.Description,
+		IsActive:    false,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{category.CategoryDeactivatedEvent, category.CategoryUpdatedEvent}, publisher.Names())
+	assert.Equal(t, []string{"name"}, eventtest.Of[category.CategoryUpdated](publisher)[0].ChangedFields)
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/identifier"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
 	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
 )
 
@@ -16,6 +18,11 @@ func (c CastMemberError) Error() string {
 	return c.msg
 }
 
+var (
+	ErrCastMemberNotFound      = CastMemberError{"cast member not found"}
+	ErrCastMemberAlreadyExists = CastMemberError{"cast member already exists"}
+)
+
 type CastMemberType string
 
 const (
@@ -23,19 +30,33 @@ const (
 	Director CastMemberType = "DIRECTOR"
 )
 
+type CastMemberID = identifier.ID[CastMember]
+
+func NewCastMemberID() CastMemberID {
+	return identifier.New[CastMember]()
+}
+
+func ParseCastMemberID(value string) (CastMemberID, error) {
+	return identifier.Parse[CastMember](value)
+}
+
 type CastMember struct {
-	ID        string
+	ID        CastMemberID
 	Name      string
 	Type      CastMemberType
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	events event.Recorder
 }
 
-func NewCastMember(name string, castMemberType CastMemberType) (*CastMember, error) {
-	now := *timeutils.TimeNow()
+// NewCastMember and the methods that change a cast member read the time from
+// clock, or from the system clock when it is nil.
+func NewCastMember(clock timeutils.Clock, name string, castMemberType CastMemberType) (*CastMember, error) {
+	now := timeutils.Now(clock)
 
 	castMember := &CastMember{
-		ID:        uuid.New().String(),
+		ID:        NewCastMemberID(),
 		Name:      name,
 		Type:      castMemberType,
 		CreatedAt: now,
@@ -45,14 +66,60 @@ func NewCastMember(name string, castMemberType CastMemberType) (*CastMember, err
 	if err := castMember.IsValid(); err != nil {
 		return nil, err
 	}
+	castMember.events.Record(CastMemberCreated{
+		CastMemberID: castMember.ID,
+		Name:         name,
+		Type:         castMemberType,
+		At:           now,
+	})
 	return castMember, nil
 }
 
-func (c *CastMember) Update(name string, castMemberType CastMemberType) error {
+// PendingEvents returns the events recorded since the last pull without
+// forgetting them, so storage can save them alongside the cast member.
+func (c *CastMember) PendingEvents() []event.Event {
+	return c.events.Peek()
+}
+
+// PullEvents returns the events recorded since the last pull and forgets
+// them. Call it once the cast member has been saved or deleted.
+func (c *CastMember) PullEvents() []event.Event {
+	return c.events.Pull()
+}
+
+// Update records CastMemberUpdated only when the name or type changes, and is
+// validated before anything is recorded.
+func (c *CastMember) Update(clock timeutils.Clock, name string, castMemberType CastMemberType) error {
+	var changed []string
+	if name != c.Name {
+		changed = append(changed, "name")
+	}
+	if castMemberType != c.Type {
+		changed = append(changed, "type")
+	}
+
 	c.Name = name
 	c.Type = castMemberType
-	c.UpdatedAt = *timeutils.TimeNow()
-	return c.IsValid()
+	c.UpdatedAt = timeutils.Now(clock)
+	if err := c.IsValid(); err != nil {
+		return err
+	}
+	if len(changed) > 0 {
+		c.events.Record(CastMemberUpdated{
+			CastMemberID:  c.ID,
+			Name:          name,
+			Type:          castMemberType,
+			ChangedFields: changed,
+			At:            c.UpdatedAt,
+		})
+	}
+	return nil
+}
+
+// Delete records that the cast member is going away; removing it from
+// storage is still up to the gateway.
+func (c *CastMember) Delete(clock timeutils.Clock) {
+	c.events.Record(CastMemberDeleted{CastMemberID: c.ID, At: timeutils.Now(clock)})
 }
 
 func (c *CastMember) IsValid() error {
@@ -61,25 +128,25 @@ func (c *CastMember) IsValid() error {
 		maxNameLength = 255
 	)
 
-	if c.ID == "" {
-		return CastMemberError{"'id' should not be empty"}
+	notification := validation.NewNotification()
+
+	if c.ID.IsZero() {
+		notification.Append("id", validation.CodeRequired, "'id' should not be empty")
 	}
 
 	if c.Name == "" {
-		return CastMemberError{"'name' should not be empty"}
-	}
-	if len(c.Name) < minNameLength || len(c.Name) > maxNameLength {
-		return CastMemberError{fmt.Sprintf(
+		notification.Append("name", validation.CodeRequired, "'name' should not be empty")
+	} else if len(c.Name) < minNameLength || len(c.Name) > maxNameLength {
+		notification.Append("name", validation.CodeLength, fmt.Sprintf(
 			"'name' must be between %d and %d characters",
 			minNameLength, maxNameLength,
-		)}
+		))
 	}
 
 	if c.Type == "" {
-		return CastMemberError{"'type' should not be empty"}
-	}
-	if c.Type != Actor && c.Type != Director {
-		return CastMemberError{"'type' must be either 'ACTOR' or 'DIRECTOR'"}
+		notification.Append("type", validation.CodeRequired, "'type' should not be empty")
+	} else if c.Type != Actor && c.Type != Director {
+		notification.Append("type", validation.CodeInvalid, "'type' must be either 'ACTOR' or 'DIRECTOR'")
 	}
-	return nil
+	return notification.Err()
 }
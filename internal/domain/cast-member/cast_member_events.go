@@ -0,0 +1,43 @@
+package castmember
+
+import "time"
+
+const (
+	CastMemberCreatedEvent = "cast_member.created"
+	CastMemberUpdatedEvent = "cast_member.updated"
+	CastMemberDeletedEvent = "cast_member.deleted"
+)
+
+type CastMemberCreated struct {
+	CastMemberID CastMemberID
+	Name         string
+	Type         CastMemberType
+	At           time.Time
+}
+
+func (e CastMemberCreated) EventName() string     { return CastMemberCreatedEvent }
+func (e CastMemberCreated) AggregateID() string   { return e.CastMemberID.String() }
+func (e CastMemberCreated) OccurredAt() time.Time { return e.At }
+
+// CastMemberUpdated carries the cast member's new name and type along with
+// which of them actually changed.
+type CastMemberUpdated struct {
+	CastMemberID  CastMemberID
+	Name          string
+	Type          CastMemberType
+	ChangedFields []string
+	At            time.Time
+}
+
+func (e CastMemberUpdated) EventName() string     { return CastMemberUpdatedEvent }
+func (e CastMemberUpdated) AggregateID() string   { return e.CastMemberID.String() }
+func (e CastMemberUpdated) OccurredAt() time.Time { return e.At }
+
+type CastMemberDeleted struct {
+	CastMemberID CastMemberID
+	At           time.Time
+}
+
+func (e CastMemberDeleted) EventName() string     { return CastMemberDeletedEvent }
+func (e CastMemberDeleted) AggregateID() string   { return e.CastMemberID.String() }
+func (e CastMemberDeleted) OccurredAt() time.Time { return e.At }
@@ -0,0 +1,51 @@
+package castmember_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+func TestGivenACastMember_WhenItIsCreatedUpdatedAndDeleted_ThenShouldRecordEachStep(t *testing.T) {
+	clock := timeutils.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, err := castmember.NewCastMember(clock, "Vin Diesel", castmember.Actor)
+	require.NoError(t, err)
+
+	clock.Advance(time.Minute)
+	require.NoError(t, c.Update(clock, "Vin Diesel", castmember.Director))
+	require.NoError(t, c.Update(clock, "Vin Diesel", castmember.Director))
+	clock.Advance(time.Minute)
+	c.Delete(clock)
+
+	assert.Equal(t, []event.Event{
+		castmember.CastMemberCreated{
+			CastMemberID: c.ID,
+			Name:         "Vin Diesel",
+			Type:         castmember.Actor,
+			At:           time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		castmember.CastMemberUpdated{
+			CastMemberID:  c.ID,
+			Name:          "Vin Diesel",
+			Type:          castmember.Director,
+			ChangedFields: []string{"type"},
+			At:            time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC),
+		},
+		castmember.CastMemberDeleted{CastMemberID: c.ID, At: time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC)},
+	}, c.PullEvents())
+	assert.Empty(t, c.PullEvents())
+}
+
+func TestGivenAnInvalidCastMemberUpdate_WhenCallUpdate_ThenShouldRecordNothing(t *testing.T) {
+	c, err := castmember.NewCastMember(nil, "Vin Diesel", castmember.Actor)
+	require.NoError(t, err)
+	c.PullEvents()
+
+	assert.Error(t, c.Update(nil, "Vin Diesel", "PRODUCER"))
+	assert.Empty(t, c.PullEvents())
+}
@@ -1,11 +1,15 @@
 package castmember
 
-import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+import (
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/gateway"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
 
-type CastMemberGateway interface {
-	Create(castMember *CastMember) (*CastMember, error)
-	Update(castMember *CastMember) (*CastMember, error)
-	DeleteByID(id string) error
-	FindByID(id string) (*CastMember, error)
-	FindAll(query pagination.SearchQuery) (*pagination.Pagination[CastMember], error)
+type CastMemberGateway = gateway.Gateway[CastMember, CastMemberID]
+
+// SearchRules lists the sorts and filters cast member listings accept; name
+// is the default sort.
+var SearchRules = pagination.SearchRules{
+	Sorts:   []string{"name", "type", "created_at"},
+	Filters: []string{"type"},
 }
@@ -1,27 +1,32 @@
 package castmember
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
 )
 
 type MockCastMemberGateway struct {
 	mock.Mock
 }
 
-func (m *MockCastMemberGateway) Create(castMember *CastMember) (*CastMember, error) {
-	args := m.Called(castMember)
+var _ CastMemberGateway = (*MockCastMemberGateway)(nil)
+
+func (m *MockCastMemberGateway) Create(ctx context.Context, castMember *CastMember) (*CastMember, error) {
+	args := m.Called(ctx, castMember)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*CastMember), nil
 }
 
-func (m *MockCastMemberGateway) Update(castMember *CastMember) (*CastMember, error) {
-	args := m.Called(castMember)
+func (m *MockCastMemberGateway) Update(ctx context.Context, castMember *CastMember) (*CastMember, error) {
+	args := m.Called(ctx, castMember)
 	if err := args.Error(1); err != nil {
 		return nil, err
 	}
@@ -29,33 +34,41 @@ func (m *MockCastMemberGateway) Update(castMember *CastMember) (*CastMember, err
 	return updated, nil
 }
 
-func (m *MockCastMemberGateway) DeleteByID(id string) error {
-	args := m.Called(id)
+func (m *MockCastMemberGateway) DeleteByID(ctx context.Context, id CastMemberID, events ...event.Event) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockCastMemberGateway) FindByID(id string) (*CastMember, error) {
-	args := m.Called(id)
+func (m *MockCastMemberGateway) FindByID(ctx context.Context, id CastMemberID) (*CastMember, error) {
+	args := m.Called(ctx, id)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*CastMember), nil
 }
 
-func (m *MockCastMemberGateway) FindAll() ([]CastMember, error) {
-	args := m.Called()
+func (m *MockCastMemberGateway) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[CastMember], error) {
+	args := m.Called(ctx, query)
 	if args.Error(1) != nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]CastMember), nil
+	return args.Get(0).(*pagination.Pagination[CastMember]), nil
+}
+
+func (m *MockCastMemberGateway) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[CastMember], error) {
+	args := m.Called(ctx, query)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.CursorPage[CastMember]), nil
 }
 
 func TestMockCastMemberGateway_Create(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	castMember := &CastMember{ID: "1"}
-	m.On("Create", castMember).Return(castMember, nil)
+	castMember := &CastMember{ID: NewCastMemberID()}
+	m.On("Create", mock.Anything, castMember).Return(castMember, nil)
 
-	result, err := m.Create(castMember)
+	result, err := m.Create(t.Context(), castMember)
 
 	assert.NoError(t, err)
 	assert.Equal(t, castMember, result)
@@ -64,11 +77,11 @@ func TestMockCastMemberGateway_Create(t *testing.T) {
 
 func TestMockCastMemberGateway_Create_Error(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	castMember := &CastMember{ID: "1"}
+	castMember := &CastMember{ID: NewCastMemberID()}
 	expectedErr := errors.New("create error")
-	m.On("Create", castMember).Return(nil, expectedErr)
+	m.On("Create", mock.Anything, castMember).Return(nil, expectedErr)
 
-	result, err := m.Create(castMember)
+	result, err := m.Create(t.Context(), castMember)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -78,10 +91,10 @@ func TestMockCastMemberGateway_Create_Error(t *testing.T) {
 
 func TestMockCastMemberGateway_Update(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	castMember := &CastMember{ID: "1"}
-	m.On("Update", castMember).Return(castMember, nil)
+	castMember := &CastMember{ID: NewCastMemberID()}
+	m.On("Update", mock.Anything, castMember).Return(castMember, nil)
 
-	result, err := m.Update(castMember)
+	result, err := m.Update(t.Context(), castMember)
 
 	assert.NoError(t, err)
 	assert.Equal(t, castMember, result)
@@ -90,11 +103,11 @@ func TestMockCastMemberGateway_Update(t *testing.T) {
 
 func TestMockCastMemberGateway_Update_Error(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	castMember := &CastMember{ID: "1"}
+	castMember := &CastMember{ID: NewCastMemberID()}
 	expectedErr := errors.New("update error")
-	m.On("Update", castMember).Return(nil, expectedErr)
+	m.On("Update", mock.Anything, castMember).Return(nil, expectedErr)
 
-	result, err := m.Update(castMember)
+	result, err := m.Update(t.Context(), castMember)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -104,10 +117,10 @@ func TestMockCastMemberGateway_Update_Error(t *testing.T) {
 
 func TestMockCastMemberGateway_DeleteByID(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	id := "1"
-	m.On("DeleteByID", id).Return(nil)
+	id := NewCastMemberID()
+	m.On("DeleteByID", mock.Anything, id).Return(nil)
 
-	err := m.DeleteByID(id)
+	err := m.DeleteByID(t.Context(), id)
 
 	assert.NoError(t, err)
 	m.AssertExpectations(t)
@@ -115,21 +128,21 @@ func TestMockCastMemberGateway_DeleteByID(t *testing.T) {
 
 func TestMockCastMemberGateway_DeleteByID_Error(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	id := "1"
+	id := NewCastMemberID()
 	expectedErr := errors.New("delete error")
-	m.On("DeleteByID", id).Return(expectedErr)
+	m.On("DeleteByID", mock.Anything, id).Return(expectedErr)
 
-	err := m.DeleteByID(id)
+	err := m.DeleteByID(t.Context(), id)
 	assert.Error(t, err)
 }
 
 func TestMockCastMemberGateway_FindByID(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	id := "1"
+	id := NewCastMemberID()
 	castMember := &CastMember{ID: id}
-	m.On("FindByID", id).Return(castMember, nil)
+	m.On("FindByID", mock.Anything, id).Return(castMember, nil)
 
-	result, err := m.FindByID(id)
+	result, err := m.FindByID(t.Context(), id)
 
 	assert.NoError(t, err)
 	assert.Equal(t, castMember, result)
@@ -138,11 +151,11 @@ func TestMockCastMemberGateway_FindByID(t *testing.T) {
 
 func TestMockCastMemberGateway_FindByID_Error(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	id := "1"
+	id := NewCastMemberID()
 	expectedErr := errors.New("find error")
-	m.On("FindByID", id).Return(nil, expectedErr)
+	m.On("FindByID", mock.Anything, id).Return(nil, expectedErr)
 
-	result, err := m.FindByID(id)
+	result, err := m.FindByID(t.Context(), id)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -152,10 +165,16 @@ func TestMockCastMemberGateway_FindByID_Error(t *testing.T) {
 
 func TestMockCastMemberGateway_FindAll(t *testing.T) {
 	m := new(MockCastMemberGateway)
-	castMembers := []CastMember{{ID: "1"}, {ID: "2"}}
-	m.On("FindAll").Return(castMembers, nil)
+	query := pagination.SearchQuery{Page: 0, PerPage: 10}
+	castMembers := &pagination.Pagination[CastMember]{
+		CurrentPage: 0,
+		PerPage:     10,
+		Total:       2,
+		Items:       []CastMember{{ID: NewCastMemberID()}, {ID: NewCastMemberID()}},
+	}
+	m.On("FindAll", mock.Anything, query).Return(castMembers, nil)
 
-	result, err := m.FindAll()
+	result, err := m.FindAll(t.Context(), query)
 
 	assert.NoError(t, err)
 	assert.Equal(t, castMembers, result)
@@ -164,10 +183,11 @@ func TestMockCastMemberGateway_FindAll(t *testing.T) {
 
 func TestMockCastMemberGateway_FindAll_Error(t *testing.T) {
 	m := new(MockCastMemberGateway)
+	query := pagination.SearchQuery{Page: 0, PerPage: 10}
 	expectedErr := errors.New("find all error")
-	m.On("FindAll").Return(nil, expectedErr)
+	m.On("FindAll", mock.Anything, query).Return(nil, expectedErr)
 
-	result, err := m.FindAll()
+	result, err := m.FindAll(t.Context(), query)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -1,11 +1,15 @@
 package castmember_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
 )
 
 const (
@@ -14,21 +18,32 @@ const (
 )
 
 func TestGivenAnEmptyID_WhenCreateANewCastMember_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := castmember.CastMember{ID: ""}
+	categoryEntity := castmember.CastMember{}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "'id' should not be empty")
 }
 
+func TestGivenAnInvalidNameAndType_WhenCallNewCastMember_ThenShouldReceiveEveryError(t *testing.T) {
+	_, err := castmember.NewCastMember(nil, "ab", castmember.CastMemberType("FLAVOR"))
+
+	var notification *validation.Notification
+	assert.True(t, errors.As(err, &notification))
+	assert.Equal(t, []validation.FieldError{
+		{Field: "name", Code: validation.CodeLength, Message: nameLengthErrorMessage},
+		{Field: "type", Code: validation.CodeInvalid, Message: "'type' must be either 'ACTOR' or 'DIRECTOR'"},
+	}, notification.Errors())
+}
+
 func TestGivenAnEmptyName_WhenCreateANewCastMember_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := castmember.CastMember{ID: "1234", Name: ""}
+	categoryEntity := castmember.CastMember{ID: castmember.NewCastMemberID(), Name: ""}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
 }
 
 func TestGivenAnInvalidNameLengthLessThan3_WhenCreateANewCastMember_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := castmember.CastMember{ID: "1234", Name: "ab"}
+	categoryEntity := castmember.CastMember{ID: castmember.NewCastMemberID(), Name: "ab"}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
@@ -36,7 +51,7 @@ func TestGivenAnInvalidNameLengthLessThan3_WhenCreateANewCastMember_ThenShouldRe
 
 func TestGivenAnInvalidNameLengthMoreThan255_WhenCreateANewCastMember_ThenShouldReceiveAnError(t *testing.T) {
 	categoryEntity := castmember.CastMember{
-		ID:   "1234",
+		ID:   castmember.NewCastMemberID(),
 		Name: strings.Repeat("a", 256),
 	}
 	err := categoryEntity.IsValid()
@@ -49,6 +64,7 @@ func TestGivenAValidParams_WhenCallNewCastMember_ThenInstantiateACastMember(t *t
 	expectedType := castmember.Actor
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -69,6 +85,7 @@ func TestGivenAnInvalidEmptyName_WhenCallNewCastMember_ThenShouldReceiveAnError(
 	expectedErrorMessage := nameEmptyErrorMessage
 
 	_, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -83,6 +100,7 @@ func TestGivenAnInvalidNameLengthLessThan3_WhenCallNewCastMember_ThenShouldRecei
 	expectedErrorMessage := nameLengthErrorMessage
 
 	_, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -97,6 +115,7 @@ func TestGivenAnInvalidNameLengthMoreThan255_WhenCallNewCastMember_ThenShouldRec
 	expectedErrorMessage := nameLengthErrorMessage
 
 	_, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -111,6 +130,7 @@ func TestGivenAnInvalidEmptyType_WhenCallNewCastMember_ThenShouldReceiveAnError(
 	expectedErrorMessage := "'type' must be either 'ACTOR' or 'DIRECTOR'"
 
 	_, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -126,6 +146,7 @@ func TestGivenAValidCastMember_WhenCallUpdate_ThenReturnUpdatedCastMember(t *tes
 	expectedUpdatedType := castmember.Actor
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -138,7 +159,7 @@ func TestGivenAValidCastMember_WhenCallUpdate_ThenReturnUpdatedCastMember(t *tes
 	assert.NotZero(t, castMember.CreatedAt)
 	assert.NotZero(t, castMember.UpdatedAt)
 
-	castMember.Update(expectedUpdatedName, expectedUpdatedType)
+	castMember.Update(nil, expectedUpdatedName, expectedUpdatedType)
 
 	assert.NotEmpty(t, castMember.ID)
 	assert.Equal(t, expectedUpdatedName, castMember.Name)
@@ -152,6 +173,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidEmptyName_ThenShouldRece
 	expectedType := castmember.Actor
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -161,7 +183,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidEmptyName_ThenShouldRece
 	assert.NotEmpty(t, castMember.ID)
 
 	// Test with empty name
-	err = castMember.Update("", expectedType)
+	err = castMember.Update(nil, "", expectedType)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
 }
@@ -171,6 +193,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidNameLengthLessThan3_Then
 	expectedType := castmember.Actor
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -179,7 +202,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidNameLengthLessThan3_Then
 	assert.NotNil(t, castMember)
 	assert.NotEmpty(t, castMember.ID)
 
-	err = castMember.Update("ab", expectedType)
+	err = castMember.Update(nil, "ab", expectedType)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
 }
@@ -189,6 +212,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidNameLengthMoreThan255_Th
 	expectedType := castmember.Actor
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -197,7 +221,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidNameLengthMoreThan255_Th
 	assert.NotNil(t, castMember)
 	assert.NotEmpty(t, castMember.ID)
 
-	err = castMember.Update(strings.Repeat("a", 256), expectedType)
+	err = castMember.Update(nil, strings.Repeat("a", 256), expectedType)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
 }
@@ -210,6 +234,7 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidType_ThenShouldReceiveAn
 	expectedErrorMessage := "'type' must be either 'ACTOR' or 'DIRECTOR'"
 
 	castMember, err := castmember.NewCastMember(
+		nil,
 		expectedName,
 		expectedType,
 	)
@@ -217,7 +242,35 @@ func TestGivenAValidCastMember_WhenCallUpdateWithInvalidType_ThenShouldReceiveAn
 	assert.NotNil(t, castMember)
 	assert.NotEmpty(t, castMember.ID)
 
-	err = castMember.Update("Steven Seagal", "INVALID")
+	err = castMember.Update(nil, "Steven Seagal", "INVALID")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), expectedErrorMessage)
 }
+
+func TestGivenAnIDString_WhenCallParseCastMemberID_ThenShouldAcceptOnlyUUIDs(t *testing.T) {
+	expectedID := castmember.NewCastMemberID()
+
+	parsedID, err := castmember.ParseCastMemberID(expectedID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, expectedID, parsedID)
+
+	_, err = castmember.ParseCastMemberID("not-a-uuid")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'id' must be a valid UUID")
+}
+
+func TestGivenAFakeClock_WhenCallNewCastMemberAndUpdate_ThenTimestampsShouldFollowTheClock(t *testing.T) {
+	createdAt := time.Date(2024, 5, 10, 12, 0, 0, 999, time.UTC)
+	clock := timeutils.NewFakeClock(createdAt)
+
+	castMember, err := castmember.NewCastMember(clock, "Vin Diesel", castmember.Actor)
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt.Truncate(time.Microsecond), castMember.CreatedAt)
+	assert.Equal(t, createdAt.Truncate(time.Microsecond), castMember.UpdatedAt)
+
+	clock.Advance(24 * time.Hour)
+	assert.NoError(t, castMember.Update(clock, "Vin Diesel", castmember.Director))
+
+	assert.Equal(t, createdAt.Truncate(time.Microsecond), castMember.CreatedAt)
+	assert.Equal(t, createdAt.Add(24*time.Hour).Truncate(time.Microsecond), castMember.UpdatedAt)
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/identifier"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
 	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
 )
 
@@ -16,24 +18,43 @@ func (c CategoryError) Error() string {
 	return c.msg
 }
 
+var (
+	ErrCategoryNotFound      = CategoryError{"category not found"}
+	ErrCategoryAlreadyExists = CategoryError{"category already exists"}
+)
+
+type CategoryID = identifier.ID[Category]
+
+func NewCategoryID() CategoryID {
+	return identifier.New[Category]()
+}
+
+func ParseCategoryID(value string) (CategoryID, error) {
+	return identifier.Parse[Category](value)
+}
+
 type Category struct {
-	ID          string
+	ID          CategoryID
 	Name        string
 	Description string
 	Active      bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   *time.Time
+
+	events event.Recorder
 }
 
-func NewCategory(name, description string, isActive bool) (*Category, error) {
-	now := *timeutils.TimeNow()
+// NewCategory and the methods that change a category read the time from
+// clock, or from the system clock when it is nil.
+func NewCategory(clock timeutils.Clock, name, description string, isActive bool) (*Category, error) {
+	now := timeutils.Now(clock)
 	var deletedAt *time.Time
 	if !isActive {
 		deletedAt = &now
 	}
 	category := &Category{
-		ID:          uuid.NewString(),
+		ID:          NewCategoryID(),
 		Name:        name,
 		Description: description,
 		Active:      isActive,
@@ -45,33 +66,83 @@ func NewCategory(name, description string, isActive bool) (*Category, error) {
 	if err != nil {
 		return nil, err
 	}
+	category.events.Record(CategoryCreated{
+		CategoryID:  category.ID,
+		Name:        name,
+		Description: description,
+		Active:      isActive,
+		At:          now,
+	})
 	return category, nil
 }
 
-func (c *Category) Activate() {
+// PendingEvents returns the events recorded since the last pull without
+// forgetting them, so storage can save them alongside the category.
+func (c *Category) PendingEvents() []event.Event {
+	return c.events.Peek()
+}
+
+// PullEvents returns the events recorded since the last pull and forgets
+// them. Call it once the category has been saved.
+func (c *Category) PullEvents() []event.Event {
+	return c.events.Pull()
+}
+
+func (c *Category) Activate(clock timeutils.Clock) {
+	now := timeutils.Now(clock)
+	if !c.Active {
+		c.events.Record(CategoryActivated{CategoryID: c.ID, At: now})
+	}
 	c.DeletedAt = nil
 	c.Active = true
-	c.UpdatedAt = *timeutils.TimeNow()
+	c.UpdatedAt = now
 }
 
-func (c *Category) Deactivate() {
+func (c *Category) Deactivate(clock timeutils.Clock) {
+	now := timeutils.Now(clock)
+	if c.Active {
+		c.events.Record(CategoryDeactivated{CategoryID: c.ID, At: now})
+	}
 	if c.DeletedAt == nil {
-		c.DeletedAt = timeutils.TimeNow()
+		c.DeletedAt = &now
 	}
 	c.Active = false
-	c.UpdatedAt = *timeutils.TimeNow()
+	c.UpdatedAt = now
 }
 
-func (c *Category) Update(name, description string, isActive bool) error {
+// Update records CategoryUpdated only when the name or description changes,
+// and is validated before anything is recorded.
+func (c *Category) Update(clock timeutils.Clock, name, description string, isActive bool) error {
+	var changed []string
+	if name != c.Name {
+		changed = append(changed, "name")
+	}
+	if description != c.Description {
+		changed = append(changed, "description")
+	}
+
+	pending := c.events
 	if isActive {
-		c.Activate()
+		c.Activate(clock)
 	} else {
-		c.Deactivate()
+		c.Deactivate(clock)
 	}
 	c.Name = name
 	c.Description = description
-	c.UpdatedAt = *timeutils.TimeNow()
-	return c.IsValid()
+	if err := c.IsValid(); err != nil {
+		c.events = pending
+		return err
+	}
+	if len(changed) > 0 {
+		c.events.Record(CategoryUpdated{
+			CategoryID:    c.ID,
+			Name:          name,
+			Description:   description,
+			ChangedFields: changed,
+			At:            c.UpdatedAt,
+		})
+	}
+	return nil
 }
 
 func (c *Category) IsValid() error {
@@ -80,18 +151,19 @@ func (c *Category) IsValid() error {
 		maxNameLength = 255
 	)
 
-	if c.ID == "" {
-		return CategoryError{"'id' should not be empty"}
+	notification := validation.NewNotification()
+
+	if c.ID.IsZero() {
+		notification.Append("id", validation.CodeRequired, "'id' should not be empty")
 	}
 
 	if c.Name == "" {
-		return CategoryError{"'name' should not be empty"}
-	}
-	if len(c.Name) < minNameLength || len(c.Name) > maxNameLength {
-		return CategoryError{fmt.Sprintf(
+		notification.Append("name", validation.CodeRequired, "'name' should not be empty")
+	} else if len(c.Name) < minNameLength || len(c.Name) > maxNameLength {
+		notification.Append("name", validation.CodeLength, fmt.Sprintf(
 			"'name' must be between %d and %d characters",
 			minNameLength, maxNameLength,
-		)}
+		))
 	}
-	return nil
+	return notification.Err()
 }
@@ -0,0 +1,54 @@
+package category
+
+import "time"
+
+const (
+	CategoryCreatedEvent     = "category.created"
+	CategoryUpdatedEvent     = "category.updated"
+	CategoryActivatedEvent   = "category.activated"
+	CategoryDeactivatedEvent = "category.deactivated"
+)
+
+type CategoryCreated struct {
+	CategoryID  CategoryID
+	Name        string
+	Description string
+	Active      bool
+	At          time.Time
+}
+
+func (e CategoryCreated) EventName() string     { return CategoryCreatedEvent }
+func (e CategoryCreated) AggregateID() string   { return e.CategoryID.String() }
+func (e CategoryCreated) OccurredAt() time.Time { return e.At }
+
+// CategoryUpdated carries the category's new name and description along with
+// which of them actually changed. Activation has events of its own.
+type CategoryUpdated struct {
+	CategoryID    CategoryID
+	Name          string
+	Description   string
+	ChangedFields []string
+	At            time.Time
+}
+
+func (e CategoryUpdated) EventName() string     { return CategoryUpdatedEvent }
+func (e CategoryUpdated) AggregateID() string   { return e.CategoryID.String() }
+func (e CategoryUpdated) OccurredAt() time.Time { return e.At }
+
+type CategoryActivated struct {
+	CategoryID CategoryID
+	At         time.Time
+}
+
+func (e CategoryActivated) EventName() string     { return CategoryActivatedEvent }
+func (e CategoryActivated) AggregateID() string   { return e.CategoryID.String() }
+func (e CategoryActivated) OccurredAt() time.Time { return e.At }
+
+type CategoryDeactivated struct {
+	CategoryID CategoryID
+	At         time.Time
+}
+
+func (e CategoryDeactivated) EventName() string     { return CategoryDeactivatedEvent }
+func (e CategoryDeactivated) AggregateID() string   { return e.CategoryID.String() }
+func (e CategoryDeactivated) OccurredAt() time.Time { return e.At }
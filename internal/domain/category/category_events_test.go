@@ -0,0 +1,79 @@
+package category_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+func TestGivenANewCategory_WhenCallPullEvents_ThenShouldReturnCategoryCreatedOnce(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c, err := category.NewCategory(timeutils.NewFakeClock(now), "Filmes", validCategoryDescription, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, []event.Event{category.CategoryCreated{
+		CategoryID:  c.ID,
+		Name:        "Filmes",
+		Description: validCategoryDescription,
+		Active:      true,
+		At:          now,
+	}}, c.PullEvents())
+	assert.Empty(t, c.PullEvents())
+}
+
+func TestGivenAnActiveCategory_WhenCallDeactivateAndActivate_ThenShouldRecordOnlyRealChanges(t *testing.T) {
+	clock := timeutils.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	c, err := category.NewCategory(clock, "Filmes", "", true)
+	require.NoError(t, err)
+	c.PullEvents()
+
+	c.Activate(clock)
+	clock.Advance(time.Minute)
+	c.Deactivate(clock)
+	c.Deactivate(clock)
+	clock.Advance(time.Minute)
+	c.Activate(clock)
+
+	assert.Equal(t, []event.Event{
+		category.CategoryDeactivated{CategoryID: c.ID, At: time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)},
+		category.CategoryActivated{CategoryID: c.ID, At: time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC)},
+	}, c.PullEvents())
+}
+
+func TestGivenACategory_WhenCallUpdate_ThenShouldRecordTheChangedFields(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := timeutils.NewFakeClock(now)
+	c, err := category.NewCategory(clock, "Filmes", "", true)
+	require.NoError(t, err)
+	c.PullEvents()
+
+	require.NoError(t, c.Update(clock, "Filmes", "Longas", false))
+
+	assert.Equal(t, []event.Event{
+		category.CategoryDeactivated{CategoryID: c.ID, At: now},
+		category.CategoryUpdated{
+			CategoryID:    c.ID,
+			Name:          "Filmes",
+			Description:   "Longas",
+			ChangedFields: []string{"description"},
+			At:            now,
+		},
+	}, c.PullEvents())
+
+	require.NoError(t, c.Update(clock, "Filmes", "Longas", false))
+	assert.Empty(t, c.PullEvents())
+}
+
+func TestGivenAnInvalidUpdate_WhenCallUpdate_ThenShouldRecordNothing(t *testing.T) {
+	c, err := category.NewCategory(nil, "Filmes", "", false)
+	require.NoError(t, err)
+	c.PullEvents()
+
+	assert.Error(t, c.Update(nil, "ab", "", true))
+	assert.Empty(t, c.PullEvents())
+}
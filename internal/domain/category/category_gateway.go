@@ -1,11 +1,13 @@
 package category
 
-import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+import (
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/gateway"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
 
-type CategoryGateway interface {
-	Create(category *Category) (*Category, error)
-	Update(category *Category) (*Category, error)
-	DeleteByID(id string) error
-	FindByID(id string) (*Category, error)
-	FindAll(query pagination.SearchQuery) (*pagination.Pagination[Category], error)
+type CategoryGateway = gateway.Gateway[Category, CategoryID]
+
+// SearchRules lists the sorts category listings accept; name is the default.
+var SearchRules = pagination.SearchRules{
+	Sorts: []string{"name", "created_at", "updated_at"},
 }
@@ -1,11 +1,15 @@
 package category_test
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
 )
 
 const (
@@ -15,21 +19,33 @@ const (
 )
 
 func TestGivenAnEmptyID_WhenCreateANewCategory_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := category.Category{ID: ""}
+	categoryEntity := category.Category{}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "'id' should not be empty")
 }
 
+func TestGivenAnEmptyIDAndName_WhenCallIsValid_ThenShouldReceiveEveryError(t *testing.T) {
+	categoryEntity := category.Category{}
+	err := categoryEntity.IsValid()
+
+	var notification *validation.Notification
+	assert.True(t, errors.As(err, &notification))
+	assert.Equal(t, []validation.FieldError{
+		{Field: "id", Code: validation.CodeRequired, Message: "'id' should not be empty"},
+		{Field: "name", Code: validation.CodeRequired, Message: nameEmptyErrorMessage},
+	}, notification.Errors())
+}
+
 func TestGivenAnEmptyName_WhenCreateANewCategory_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := category.Category{ID: "1234", Name: ""}
+	categoryEntity := category.Category{ID: category.NewCategoryID(), Name: ""}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
 }
 
 func TestGivenAnInvalidNameLengthLessThan3_WhenCreateANewCategory_ThenShouldReceiveAnError(t *testing.T) {
-	categoryEntity := category.Category{ID: "1234", Name: "ab"}
+	categoryEntity := category.Category{ID: category.NewCategoryID(), Name: "ab"}
 	err := categoryEntity.IsValid()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
@@ -37,7 +53,7 @@ func TestGivenAnInvalidNameLengthLessThan3_WhenCreateANewCategory_ThenShouldRece
 
 func TestGivenAnInvalidNameLengthMoreThan255_WhenCreateANewCategory_ThenShouldReceiveAnError(t *testing.T) {
 	categoryEntity := category.Category{
-		ID:   "1234",
+		ID:   category.NewCategoryID(),
 		Name: strings.Repeat("a", 256),
 	}
 	err := categoryEntity.IsValid()
@@ -51,6 +67,7 @@ func TestGivenAValidParams_WhenCallNewCategory_ThenInstantiateACategory(t *testi
 	expectedActive := true
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -75,6 +92,7 @@ func TestGivenAnInvalidEmptyName_WhenCallNewCategory_ThenShouldReceiveAnError(t
 	expectedErrorMessage := nameEmptyErrorMessage
 
 	_, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -91,6 +109,7 @@ func TestGivenAnInvalidNameLengthLessThan3_WhenCallNewCategory_ThenShouldReceive
 	expectedErrorMessage := nameLengthErrorMessage
 
 	_, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -107,6 +126,7 @@ func TestGivenAnInvalidNameLengthMoreThan255_WhenCallNewCategory_ThenShouldRecei
 	expectedErrorMessage := nameLengthErrorMessage
 
 	_, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -121,6 +141,7 @@ func TestGivenAValidEmptyDescription_WhenCallNewCategoryAndValidate_ThenShouldRe
 	expectedActive := true
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -143,6 +164,7 @@ func TestGivenAValidFalseIsActive_WhenCallNewCategoryAndValidate_ThenShouldRecei
 	expectedActive := false
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -165,6 +187,7 @@ func TestGivenAValidActiveCategory_whenCallDeactivate_thenReturnCategoryInactiva
 	expectedActive := true
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -180,7 +203,7 @@ func TestGivenAValidActiveCategory_whenCallDeactivate_thenReturnCategoryInactiva
 	assert.NotZero(t, categoryEntity.UpdatedAt)
 	assert.Nil(t, categoryEntity.DeletedAt)
 
-	categoryEntity.Deactivate()
+	categoryEntity.Deactivate(nil)
 
 	assert.NotEmpty(t, categoryEntity.ID)
 	assert.Equal(t, expectedName, categoryEntity.Name)
@@ -197,6 +220,7 @@ func TestGivenAValidInactiveCategory_whenCallActivate_thenReturnCategoryActivate
 	expectedActive := false
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -212,7 +236,7 @@ func TestGivenAValidInactiveCategory_whenCallActivate_thenReturnCategoryActivate
 	assert.NotZero(t, categoryEntity.UpdatedAt)
 	assert.NotNil(t, categoryEntity.DeletedAt)
 
-	categoryEntity.Activate()
+	categoryEntity.Activate(nil)
 
 	assert.NotEmpty(t, categoryEntity.ID)
 	assert.Equal(t, expectedName, categoryEntity.Name)
@@ -232,6 +256,7 @@ func TestGivenAValidCategory_WhenCallUpdate_ThenReturnUpdatedCategory(t *testing
 	expectedUpdatedDescription := "A categoria mais assistida - Atualizada"
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -247,7 +272,7 @@ func TestGivenAValidCategory_WhenCallUpdate_ThenReturnUpdatedCategory(t *testing
 	assert.NotZero(t, categoryEntity.UpdatedAt)
 	assert.Nil(t, categoryEntity.DeletedAt)
 
-	categoryEntity.Update(expectedUpdatedName, expectedUpdatedDescription, false)
+	categoryEntity.Update(nil, expectedUpdatedName, expectedUpdatedDescription, false)
 
 	assert.NotEmpty(t, categoryEntity.ID)
 	assert.Equal(t, expectedUpdatedName, categoryEntity.Name)
@@ -267,6 +292,7 @@ func TestGivenAValidCategory_WhenCallUpdateToInactive_ThenReturnUpdatedCategory(
 	expectedUpdatedDescription := "A categoria mais assistida - Atualizada"
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -282,7 +308,7 @@ func TestGivenAValidCategory_WhenCallUpdateToInactive_ThenReturnUpdatedCategory(
 	assert.NotZero(t, categoryEntity.UpdatedAt)
 	assert.NotNil(t, categoryEntity.DeletedAt)
 
-	categoryEntity.Update(expectedUpdatedName, expectedUpdatedDescription, false)
+	categoryEntity.Update(nil, expectedUpdatedName, expectedUpdatedDescription, false)
 
 	assert.NotEmpty(t, categoryEntity.ID)
 	assert.Equal(t, expectedUpdatedName, categoryEntity.Name)
@@ -299,6 +325,7 @@ func TestGivenAValidCategory_WhenCallUpdateWithInvalidParams_ThenShouldReceiveAn
 	expectedActive := true
 
 	categoryEntity, err := category.NewCategory(
+		nil,
 		expectedName,
 		expectedDescription,
 		expectedActive,
@@ -308,18 +335,74 @@ func TestGivenAValidCategory_WhenCallUpdateWithInvalidParams_ThenShouldReceiveAn
 	assert.NotNil(t, categoryEntity)
 	assert.NotEmpty(t, categoryEntity.ID)
 
-	err = categoryEntity.Update("", expectedDescription, expectedActive)
+	err = categoryEntity.Update(nil, "", expectedDescription, expectedActive)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
 
-	err = categoryEntity.Update("ab", "", expectedActive)
+	err = categoryEntity.Update(nil, "ab", "", expectedActive)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
 
-	err = categoryEntity.Update(strings.Repeat("a", 256), "", expectedActive)
+	err = categoryEntity.Update(nil, strings.Repeat("a", 256), "", expectedActive)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), nameLengthErrorMessage)
 
-	err = categoryEntity.Update(expectedName, expectedDescription, true)
+	err = categoryEntity.Update(nil, expectedName, expectedDescription, true)
+	assert.NoError(t, err)
+}
+
+func TestGivenAnIDString_WhenCallParseCategoryID_ThenShouldAcceptOnlyUUIDs(t *testing.T) {
+	expectedID := category.NewCategoryID()
+
+	parsedID, err := category.ParseCategoryID(expectedID.String())
 	assert.NoError(t, err)
+	assert.Equal(t, expectedID, parsedID)
+
+	_, err = category.ParseCategoryID("1234")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'id' must be a valid UUID")
+}
+
+func TestGivenAFakeClock_WhenCallNewCategory_ThenTimestampsShouldMatchTheClock(t *testing.T) {
+	expectedNow := time.Date(2024, 5, 10, 12, 0, 0, 123456789, time.UTC)
+	clock := timeutils.NewFakeClock(expectedNow)
+
+	categoryEntity, err := category.NewCategory(clock, "Filmes", validCategoryDescription, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedNow.Truncate(time.Microsecond), categoryEntity.CreatedAt)
+	assert.Equal(t, expectedNow.Truncate(time.Microsecond), categoryEntity.UpdatedAt)
+	assert.Equal(t, expectedNow.Truncate(time.Microsecond), *categoryEntity.DeletedAt)
+}
+
+func TestGivenAFakeClock_WhenTimePassesBetweenChanges_ThenUpdatedAtShouldFollowTheClock(t *testing.T) {
+	createdAt := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	clock := timeutils.NewFakeClock(createdAt)
+
+	categoryEntity, err := category.NewCategory(clock, "Filmes", validCategoryDescription, true)
+	assert.NoError(t, err)
+
+	clock.Advance(time.Hour)
+	categoryEntity.Deactivate(clock)
+	assert.Equal(t, createdAt, categoryEntity.CreatedAt)
+	assert.Equal(t, createdAt.Add(time.Hour), categoryEntity.UpdatedAt)
+	assert.Equal(t, createdAt.Add(time.Hour), *categoryEntity.DeletedAt)
+
+	clock.Advance(time.Hour)
+	categoryEntity.Activate(clock)
+	assert.Equal(t, createdAt.Add(2*time.Hour), categoryEntity.UpdatedAt)
+	assert.Nil(t, categoryEntity.DeletedAt)
+
+	clock.Advance(time.Hour)
+	assert.NoError(t, categoryEntity.Update(clock, "Series", "", true))
+	assert.Equal(t, createdAt.Add(3*time.Hour), categoryEntity.UpdatedAt)
+}
+
+func TestGivenARehydratedCategory_WhenCallActivateWithAClock_ThenShouldUseTheGivenClock(t *testing.T) {
+	expectedNow := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	categoryEntity := category.Category{ID: category.NewCategoryID(), Name: "Filmes"}
+
+	categoryEntity.Activate(timeutils.NewFakeClock(expectedNow))
+
+	assert.Equal(t, expectedNow, categoryEntity.UpdatedAt)
 }
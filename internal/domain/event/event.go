@@ -0,0 +1,54 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Event is something that happened to an aggregate. Aggregates record events
+// as their state changes; the application pulls them once the change has
+// been saved and hands them to whoever needs to react.
+type Event interface {
+	// EventName identifies the kind of event, such as "category.created".
+	EventName() string
+	AggregateID() string
+	OccurredAt() time.Time
+}
+
+// Recorder holds the events an aggregate has raised but nobody has pulled
+// yet. Aggregates keep it in an unexported field so only they can record.
+// The zero value is ready to use.
+type Recorder struct {
+	events []Event
+}
+
+func (r *Recorder) Record(e Event) {
+	r.events = append(r.events, e)
+}
+
+// Peek returns the recorded events, oldest first, leaving them in place.
+func (r *Recorder) Peek() []Event {
+	return append([]Event(nil), r.events...)
+}
+
+// Pull returns the recorded events, oldest first, and forgets them.
+func (r *Recorder) Pull() []Event {
+	events := r.events
+	r.events = nil
+	return events
+}
+
+// Publisher hands events to whoever reacts to them. Publishing happens after
+// the change has been saved, so it never fails the publisher: delivering,
+// retrying and reporting failed deliveries is the Publisher's job.
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event)
+}
+
+// Discard is a Publisher that drops every event, for setups where something
+// else, such as a transactional outbox, is responsible for delivering them.
+var Discard Publisher = discard{}
+
+type discard struct{}
+
+func (discard) Publish(context.Context, ...Event) {}
@@ -0,0 +1,24 @@
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+)
+
+type somethingHappened struct{ id string }
+
+func (e somethingHappened) EventName() string     { return "something.happened" }
+func (e somethingHappened) AggregateID() string   { return e.id }
+func (e somethingHappened) OccurredAt() time.Time { return time.Time{} }
+
+func TestGivenRecordedEvents_WhenCallPull_ThenShouldReturnThemInOrderOnce(t *testing.T) {
+	var recorder event.Recorder
+	recorder.Record(somethingHappened{id: "1"})
+	recorder.Record(somethingHappened{id: "2"})
+
+	assert.Equal(t, []event.Event{somethingHappened{id: "1"}, somethingHappened{id: "2"}}, recorder.Pull())
+	assert.Empty(t, recorder.Pull())
+}
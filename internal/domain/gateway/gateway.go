@@ -0,0 +1,23 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// Gateway is the persistence port shared by every aggregate: T is the
+// aggregate and ID its typed identifier. Create and Update store the events
+// the aggregate has pending along with its state; DeleteByID stores the events
+// it is given, and none when there was no row to delete. Every method gives up once ctx is done and then returns ctx.Err(), possibly
+// wrapped, so callers can tell a cancellation or deadline apart from a storage
+// failure.
+type Gateway[T any, ID comparable] interface {
+	Create(ctx context.Context, entity *T) (*T, error)
+	Update(ctx context.Context, entity *T) (*T, error)
+	DeleteByID(ctx context.Context, id ID, events ...event.Event) error
+	FindByID(ctx context.Context, id ID) (*T, error)
+	FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[T], error)
+	FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[T], error)
+}
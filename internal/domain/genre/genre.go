@@ -0,0 +1,151 @@
+package genre
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/identifier"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type GenreError struct {
+	msg string
+}
+
+func (g GenreError) Error() string {
+	return g.msg
+}
+
+var (
+	ErrGenreNotFound      = GenreError{"genre not found"}
+	ErrGenreAlreadyExists = GenreError{"genre already exists"}
+)
+
+type GenreID = identifier.ID[Genre]
+
+func NewGenreID() GenreID {
+	return identifier.New[Genre]()
+}
+
+func ParseGenreID(value string) (GenreID, error) {
+	return identifier.Parse[Genre](value)
+}
+
+type Genre struct {
+	ID          GenreID
+	Name        string
+	Active      bool
+	CategoryIDs []category.CategoryID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// NewGenre and the methods that change a genre read the time from clock, or
+// from the system clock when it is nil.
+func NewGenre(clock timeutils.Clock, name string, isActive bool, categoryIDs ...category.CategoryID) (*Genre, error) {
+	now := timeutils.Now(clock)
+	var deletedAt *time.Time
+	if !isActive {
+		deletedAt = &now
+	}
+	genre := &Genre{
+		ID:          NewGenreID(),
+		Name:        name,
+		Active:      isActive,
+		CategoryIDs: []category.CategoryID{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   deletedAt,
+	}
+	genre.addCategories(categoryIDs)
+	err := genre.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return genre, nil
+}
+
+func (g *Genre) Activate(clock timeutils.Clock) {
+	g.DeletedAt = nil
+	g.Active = true
+	g.UpdatedAt = timeutils.Now(clock)
+}
+
+func (g *Genre) Deactivate(clock timeutils.Clock) {
+	now := timeutils.Now(clock)
+	if g.DeletedAt == nil {
+		g.DeletedAt = &now
+	}
+	g.Active = false
+	g.UpdatedAt = now
+}
+
+func (g *Genre) Update(clock timeutils.Clock, name string, isActive bool, categoryIDs []category.CategoryID) error {
+	if isActive {
+		g.Activate(clock)
+	} else {
+		g.Deactivate(clock)
+	}
+	g.Name = name
+	g.CategoryIDs = []category.CategoryID{}
+	g.addCategories(categoryIDs)
+	return g.IsValid()
+}
+
+func (g *Genre) AddCategory(clock timeutils.Clock, categoryID category.CategoryID) {
+	if g.addCategories([]category.CategoryID{categoryID}) {
+		g.UpdatedAt = timeutils.Now(clock)
+	}
+}
+
+func (g *Genre) RemoveCategory(clock timeutils.Clock, categoryID category.CategoryID) {
+	index := slices.Index(g.CategoryIDs, categoryID)
+	if index < 0 {
+		return
+	}
+	g.CategoryIDs = slices.Delete(g.CategoryIDs, index, index+1)
+	g.UpdatedAt = timeutils.Now(clock)
+}
+
+func (g *Genre) HasCategory(categoryID category.CategoryID) bool {
+	return slices.Contains(g.CategoryIDs, categoryID)
+}
+
+func (g *Genre) addCategories(categoryIDs []category.CategoryID) bool {
+	changed := false
+	for _, id := range categoryIDs {
+		if id.IsZero() || slices.Contains(g.CategoryIDs, id) {
+			continue
+		}
+		g.CategoryIDs = append(g.CategoryIDs, id)
+		changed = true
+	}
+	return changed
+}
+
+func (g *Genre) IsValid() error {
+	const (
+		minNameLength = 3
+		maxNameLength = 255
+	)
+
+	notification := validation.NewNotification()
+
+	if g.ID.IsZero() {
+		notification.Append("id", validation.CodeRequired, "'id' should not be empty")
+	}
+
+	if g.Name == "" {
+		notification.Append("name", validation.CodeRequired, "'name' should not be empty")
+	} else if len(g.Name) < minNameLength || len(g.Name) > maxNameLength {
+		notification.Append("name", validation.CodeLength, fmt.Sprintf(
+			"'name' must be between %d and %d characters",
+			minNameLength, maxNameLength,
+		))
+	}
+	return notification.Err()
+}
@@ -0,0 +1,5 @@
+package genre
+
+import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/gateway"
+
+type GenreGateway = gateway.Gateway[Genre, GenreID]
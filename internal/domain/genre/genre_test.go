@@ -0,0 +1,166 @@
+package genre_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/genre"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+const (
+	nameEmptyErrorMessage  = "'name' should not be empty"
+	nameLengthErrorMessage = "'name' must be between 3 and 255 characters"
+)
+
+var (
+	categoryID1 = category.NewCategoryID()
+	categoryID2 = category.NewCategoryID()
+	categoryID3 = category.NewCategoryID()
+)
+
+func TestGivenAnEmptyID_WhenCreateANewGenre_ThenShouldReceiveAnError(t *testing.T) {
+	genreEntity := genre.Genre{}
+	err := genreEntity.IsValid()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'id' should not be empty")
+}
+
+func TestGivenAnEmptyName_WhenCreateANewGenre_ThenShouldReceiveAnError(t *testing.T) {
+	genreEntity := genre.Genre{ID: genre.NewGenreID(), Name: ""}
+	err := genreEntity.IsValid()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
+}
+
+func TestGivenAnInvalidNameLength_WhenCreateANewGenre_ThenShouldReceiveAnError(t *testing.T) {
+	for _, name := range []string{"ab", strings.Repeat("a", 256)} {
+		genreEntity := genre.Genre{ID: genre.NewGenreID(), Name: name}
+		err := genreEntity.IsValid()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), nameLengthErrorMessage)
+	}
+}
+
+func TestGivenAValidParams_WhenCallNewGenre_ThenInstantiateAGenre(t *testing.T) {
+	expectedName := "Acao"
+	expectedActive := true
+	expectedCategoryIDs := []category.CategoryID{categoryID1, categoryID2}
+
+	genreEntity, err := genre.NewGenre(nil, expectedName, expectedActive, categoryID1, categoryID2, categoryID1, category.CategoryID{})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, genreEntity)
+	assert.NotEmpty(t, genreEntity.ID)
+	assert.Equal(t, expectedName, genreEntity.Name)
+	assert.Equal(t, expectedActive, genreEntity.Active)
+	assert.Equal(t, expectedCategoryIDs, genreEntity.CategoryIDs)
+	assert.NotZero(t, genreEntity.CreatedAt)
+	assert.NotZero(t, genreEntity.UpdatedAt)
+	assert.Nil(t, genreEntity.DeletedAt)
+}
+
+func TestGivenAValidParamsWithoutCategories_WhenCallNewGenre_ThenCategoryIDsShouldBeEmpty(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", false)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, genreEntity.CategoryIDs)
+	assert.Empty(t, genreEntity.CategoryIDs)
+	assert.False(t, genreEntity.Active)
+	assert.NotNil(t, genreEntity.DeletedAt)
+}
+
+func TestGivenAnInvalidName_WhenCallNewGenre_ThenShouldReceiveAnError(t *testing.T) {
+	_, err := genre.NewGenre(nil, "", true)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
+
+	_, err = genre.NewGenre(nil, "ab", true)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), nameLengthErrorMessage)
+}
+
+func TestGivenAValidActiveGenre_WhenCallDeactivateAndActivate_ThenShouldToggleState(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", true)
+	assert.NoError(t, err)
+
+	genreEntity.Deactivate(nil)
+	assert.False(t, genreEntity.Active)
+	assert.NotNil(t, genreEntity.DeletedAt)
+
+	genreEntity.Activate(nil)
+	assert.True(t, genreEntity.Active)
+	assert.Nil(t, genreEntity.DeletedAt)
+}
+
+func TestGivenAValidGenre_WhenCallAddCategory_ThenShouldAppendOnlyNewIDs(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", true)
+	assert.NoError(t, err)
+	updatedAt := genreEntity.UpdatedAt
+
+	genreEntity.AddCategory(nil, categoryID1)
+	genreEntity.AddCategory(nil, categoryID1)
+	genreEntity.AddCategory(nil, category.CategoryID{})
+
+	assert.Equal(t, []category.CategoryID{categoryID1}, genreEntity.CategoryIDs)
+	assert.True(t, genreEntity.HasCategory(categoryID1))
+	assert.False(t, genreEntity.UpdatedAt.Before(updatedAt))
+}
+
+func TestGivenAGenreWithCategories_WhenCallRemoveCategory_ThenShouldRemoveOnlyThatID(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", true, categoryID1, categoryID2, categoryID3)
+	assert.NoError(t, err)
+
+	genreEntity.RemoveCategory(nil, categoryID2)
+	genreEntity.RemoveCategory(nil, category.NewCategoryID())
+
+	assert.Equal(t, []category.CategoryID{categoryID1, categoryID3}, genreEntity.CategoryIDs)
+	assert.False(t, genreEntity.HasCategory(categoryID2))
+}
+
+func TestGivenAValidGenre_WhenCallUpdate_ThenReturnUpdatedGenre(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", true, categoryID1)
+	assert.NoError(t, err)
+
+	err = genreEntity.Update(nil, "Aventura", false, []category.CategoryID{categoryID2, categoryID3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Aventura", genreEntity.Name)
+	assert.False(t, genreEntity.Active)
+	assert.NotNil(t, genreEntity.DeletedAt)
+	assert.Equal(t, []category.CategoryID{categoryID2, categoryID3}, genreEntity.CategoryIDs)
+}
+
+func TestGivenAValidGenre_WhenCallUpdateWithInvalidParams_ThenShouldReceiveAnError(t *testing.T) {
+	genreEntity, err := genre.NewGenre(nil, "Acao", true)
+	assert.NoError(t, err)
+
+	err = genreEntity.Update(nil, "", true, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), nameEmptyErrorMessage)
+
+	err = genreEntity.Update(nil, strings.Repeat("a", 256), true, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), nameLengthErrorMessage)
+}
+
+func TestGivenAFakeClock_WhenAGenreChanges_ThenTimestampsShouldFollowTheClock(t *testing.T) {
+	createdAt := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	clock := timeutils.NewFakeClock(createdAt)
+
+	genreEntity, err := genre.NewGenre(clock, "Acao", true)
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt, genreEntity.CreatedAt)
+
+	clock.Advance(time.Hour)
+	genreEntity.Deactivate(clock)
+	assert.Equal(t, createdAt.Add(time.Hour), genreEntity.UpdatedAt)
+	assert.Equal(t, createdAt.Add(time.Hour), *genreEntity.DeletedAt)
+
+	clock.Advance(time.Hour)
+	genreEntity.AddCategory(clock, categoryID1)
+	assert.Equal(t, createdAt.Add(2*time.Hour), genreEntity.UpdatedAt)
+}
@@ -0,0 +1,91 @@
+package identifier
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ParseError struct {
+	Value string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("'id' must be a valid UUID, got '%s'", e.Value)
+}
+
+// ID is a UUID tagged with the entity it identifies, so an ID of one
+// aggregate cannot be passed where another aggregate's ID is expected.
+type ID[T any] struct {
+	value uuid.UUID
+}
+
+func New[T any]() ID[T] {
+	return ID[T]{value: uuid.New()}
+}
+
+func Parse[T any](value string) (ID[T], error) {
+	parsed, err := uuid.Parse(value)
+	if err != nil || len(value) != len(parsed.String()) {
+		return ID[T]{}, ParseError{Value: value}
+	}
+	return ID[T]{value: parsed}, nil
+}
+
+func MustParse[T any](value string) ID[T] {
+	id, err := Parse[T](value)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (id ID[T]) IsZero() bool {
+	return id.value == uuid.Nil
+}
+
+func (id ID[T]) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.value.String()
+}
+
+func (id ID[T]) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *ID[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*id = ID[T]{}
+		return nil
+	}
+	parsed, err := Parse[T](string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+func (id ID[T]) Value() (driver.Value, error) {
+	if id.IsZero() {
+		return nil, nil
+	}
+	return id.String(), nil
+}
+
+func (id *ID[T]) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*id = ID[T]{}
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(v))
+	case []byte:
+		return id.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into an id", src)
+	}
+}
@@ -0,0 +1,95 @@
+package identifier_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/identifier"
+)
+
+type entity struct{}
+
+type entityID = identifier.ID[entity]
+
+const validUUID = "0b6f3b7c-5b8e-4a43-9c57-4bd2f1d2a3e1"
+
+func TestGivenAValidUUID_WhenCallParse_ThenShouldReturnTheID(t *testing.T) {
+	id, err := identifier.Parse[entity](validUUID)
+
+	assert.NoError(t, err)
+	assert.False(t, id.IsZero())
+	assert.Equal(t, validUUID, id.String())
+}
+
+func TestGivenAnInvalidValue_WhenCallParse_ThenShouldReceiveAParseError(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"1234",
+		"not-a-uuid",
+		"0b6f3b7c5b8e4a439c574bd2f1d2a3e1",
+		"urn:uuid:" + validUUID,
+	} {
+		_, err := identifier.Parse[entity](value)
+
+		var parseErr identifier.ParseError
+		assert.True(t, errors.As(err, &parseErr), value)
+		assert.Equal(t, value, parseErr.Value)
+	}
+}
+
+func TestGivenNew_WhenCallTwice_ThenShouldGenerateDistinctIDs(t *testing.T) {
+	first := identifier.New[entity]()
+	second := identifier.New[entity]()
+
+	assert.False(t, first.IsZero())
+	assert.NotEqual(t, first, second)
+}
+
+func TestGivenAZeroID_WhenCallString_ThenShouldBeEmpty(t *testing.T) {
+	var id entityID
+
+	assert.True(t, id.IsZero())
+	assert.Equal(t, "", id.String())
+
+	value, err := id.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestGivenAnID_WhenMarshalToJSON_ThenShouldRoundTrip(t *testing.T) {
+	payload := struct {
+		ID entityID `json:"id"`
+	}{ID: identifier.MustParse[entity](validUUID)}
+
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id":"`+validUUID+`"}`, string(data))
+
+	payload.ID = entityID{}
+	require.NoError(t, json.Unmarshal(data, &payload))
+	assert.Equal(t, validUUID, payload.ID.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"id":"1234"}`), &payload))
+}
+
+func TestGivenDatabaseValues_WhenCallScan_ThenShouldParseThem(t *testing.T) {
+	var id entityID
+
+	assert.NoError(t, id.Scan(validUUID))
+	assert.Equal(t, validUUID, id.String())
+
+	assert.NoError(t, id.Scan([]byte(validUUID)))
+	assert.Equal(t, validUUID, id.String())
+
+	assert.NoError(t, id.Scan(nil))
+	assert.True(t, id.IsZero())
+
+	assert.Error(t, id.Scan(42))
+
+	value, err := identifier.MustParse[entity](validUUID).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, validUUID, value)
+}
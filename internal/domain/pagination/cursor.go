@@ -0,0 +1,276 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+// Cursor marks a position in an ordered listing: the sort keys and ID of
+// the last item a client has seen. Keys are encoded so that comparing them as
+// strings yields the listing order.
+type Cursor struct {
+	Sort      string   `json:"s"`
+	Direction string   `json:"d"`
+	Keys      []string `json:"k"`
+	ID        string   `json:"i"`
+}
+
+// CursorCodec turns cursors into opaque tokens signed with HMAC-SHA256, so
+// clients cannot forge or edit a position.
+type CursorCodec struct {
+	secret []byte
+}
+
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: slices.Clone(secret)}
+}
+
+func (c *CursorCodec) Encode(cursor Cursor) string {
+	payload, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+}
+
+func (c *CursorCodec) Decode(token string) (*Cursor, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, invalidCursor()
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, invalidCursor()
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, c.sign(payload)) {
+		return nil, invalidCursor()
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, invalidCursor()
+	}
+	return &cursor, nil
+}
+
+func (c *CursorCodec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func invalidCursor() error {
+	return validation.NewNotification().
+		Append("cursor", validation.CodeInvalid, "'cursor' is malformed or has been tampered with")
+}
+
+// CursorRequest is a cursor listing as clients ask for it, with After and
+// Before still in their token form.
+type CursorRequest struct {
+	Size      int
+	Terms     string
+	Sort      string
+	Direction string
+	Filters   map[string]string
+	After     string
+	Before    string
+}
+
+// Query decodes the request's tokens with codec and applies the defaults and
+// limits of rules, the way SearchQuery.Normalize does for numbered pages.
+func (r CursorRequest) Query(codec *CursorCodec, rules SearchRules) (CursorQuery, error) {
+	if r.Size < 0 {
+		return CursorQuery{}, validation.NewNotification().
+			Append("size", validation.CodeRange, fmt.Sprintf("'size' must not be negative, got %d", r.Size))
+	}
+	search, err := SearchQuery{
+		PerPage:   r.Size,
+		Terms:     r.Terms,
+		Sort:      r.Sort,
+		Direction: r.Direction,
+		Filters:   r.Filters,
+	}.Normalize(rules)
+	if err != nil {
+		return CursorQuery{}, err
+	}
+
+	query := CursorQuery{
+		Size:      search.PerPage,
+		Terms:     search.Terms,
+		Sort:      search.Sort,
+		Direction: search.Direction,
+		Filters:   search.Filters,
+	}
+	if r.After != "" {
+		if query.After, err = codec.Decode(r.After); err != nil {
+			return CursorQuery{}, err
+		}
+	}
+	if r.Before != "" {
+		if query.Before, err = codec.Decode(r.Before); err != nil {
+			return CursorQuery{}, err
+		}
+	}
+	if err := query.Validate(); err != nil {
+		return CursorQuery{}, err
+	}
+	return query, nil
+}
+
+// CursorQuery asks for up to Size items strictly after After or strictly
+// before Before; with neither set it starts from the beginning.
+type CursorQuery struct {
+	Size      int
+	Terms     string
+	Sort      string
+	Direction string
+	Filters   map[string]string
+	After     *Cursor
+	Before    *Cursor
+}
+
+func (q CursorQuery) SearchQuery() SearchQuery {
+	return SearchQuery{
+		PerPage:   q.Size,
+		Terms:     q.Terms,
+		Sort:      q.Sort,
+		Direction: q.Direction,
+		Filters:   q.Filters,
+	}
+}
+
+// Position returns the cursor to seek from and whether the traversal goes
+// backwards.
+func (q CursorQuery) Position() (*Cursor, bool) {
+	if q.Before != nil {
+		return q.Before, true
+	}
+	return q.After, false
+}
+
+func (q CursorQuery) Validate() error {
+	notification := validation.NewNotification()
+	if q.Size <= 0 {
+		notification.Append("size", validation.CodeRange, fmt.Sprintf("'size' must be greater than zero, got %d", q.Size))
+	}
+	if q.After != nil && q.Before != nil {
+		notification.Append("cursor", validation.CodeInvalid, "only one of 'after' and 'before' can be given")
+	}
+	if cursor, _ := q.Position(); cursor != nil &&
+		(cursor.Sort != q.Sort || !strings.EqualFold(cursor.Direction, q.Direction)) {
+		notification.Append("cursor", validation.CodeInvalid, "'cursor' belongs to a listing with a different sort or direction")
+	}
+	return notification.Err()
+}
+
+// CursorPage is a window of an ordered listing. Next and Prev are nil when
+// there is nothing further in that direction.
+type CursorPage[T any] struct {
+	Size  int
+	Items []T
+	Next  *Cursor
+	Prev  *Cursor
+}
+
+// NewCursorPage builds a page from up to Size+1 items fetched in traversal
+// order (reversed when going backwards); the extra item only signals that
+// more results exist. position returns the sort keys and ID of an item.
+func NewCursorPage[T any](query CursorQuery, fetched []T, position func(item *T) ([]string, string)) *CursorPage[T] {
+	_, backward := query.Position()
+	hasMore := len(fetched) > query.Size
+	items := slices.Clone(fetched[:min(len(fetched), query.Size)])
+	if backward {
+		slices.Reverse(items)
+	}
+
+	page := &CursorPage[T]{Size: query.Size, Items: items}
+	if len(items) == 0 {
+		return page
+	}
+	cursorAt := func(item *T) *Cursor {
+		keys, id := position(item)
+		return &Cursor{Sort: query.Sort, Direction: query.Direction, Keys: keys, ID: id}
+	}
+	if (!backward && hasMore) || (backward && query.Before != nil) {
+		page.Next = cursorAt(&items[len(items)-1])
+	}
+	if (backward && hasMore) || (!backward && query.After != nil) {
+		page.Prev = cursorAt(&items[0])
+	}
+	return page
+}
+
+// TokenPage is a CursorPage as clients see it: Next and Prev are the tokens
+// to send back as after and before, empty when there is no such page.
+type TokenPage[T any] struct {
+	Size  int    `json:"size"`
+	Items []T    `json:"items"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
+// EncodePage signs the cursors of page with codec and converts its items
+// with mapper.
+func EncodePage[T, R any](codec *CursorCodec, page *CursorPage[T], mapper func(T) R) *TokenPage[R] {
+	result := &TokenPage[R]{Size: page.Size, Items: make([]R, len(page.Items))}
+	for i, item := range page.Items {
+		result.Items[i] = mapper(item)
+	}
+	if page.Next != nil {
+		result.Next = codec.Encode(*page.Next)
+	}
+	if page.Prev != nil {
+		result.Prev = codec.Encode(*page.Prev)
+	}
+	return result
+}
+
+// MapTokenItems converts the items of page with mapper, keeping its tokens.
+func MapTokenItems[T, R any](page *TokenPage[T], mapper func(T) R) *TokenPage[R] {
+	result := &TokenPage[R]{Size: page.Size, Items: make([]R, len(page.Items)), Next: page.Next, Prev: page.Prev}
+	for i, item := range page.Items {
+		result.Items[i] = mapper(item)
+	}
+	return result
+}
+
+// ComparePosition orders two positions of a listing: keys follow the
+// listing direction and IDs always break ties in ascending order.
+func ComparePosition(aKeys []string, aID string, bKeys []string, bID string, desc bool) int {
+	for i := range min(len(aKeys), len(bKeys)) {
+		if cmp := strings.Compare(aKeys[i], bKeys[i]); cmp != 0 {
+			if desc {
+				return -cmp
+			}
+			return cmp
+		}
+	}
+	return strings.Compare(aID, bID)
+}
+
+const timeKeyLayout = "2006-01-02T15:04:05.000000000Z"
+
+// TextKey encodes text the way listings sort it: case-insensitively.
+func TextKey(value string) string {
+	return strings.ToLower(value)
+}
+
+// TimeKey encodes an instant as fixed-width UTC, so string order is time order.
+func TimeKey(value time.Time) string {
+	return value.UTC().Format(timeKeyLayout)
+}
+
+func ParseTimeKey(key string) (time.Time, error) {
+	return time.Parse(timeKeyLayout, key)
+}
+
+// IntKey encodes a non-negative integer zero-padded, so string order is
+// numeric order.
+func IntKey(value int) string {
+	return fmt.Sprintf("%020d", value)
+}
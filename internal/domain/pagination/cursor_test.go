@@ -0,0 +1,125 @@
+package pagination_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+func TestGivenACursor_WhenEncodeAndDecode_ThenShouldRoundTrip(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	cursor := pagination.Cursor{Sort: "name", Direction: "asc", Keys: []string{"filmes"}, ID: "6f1c2f3a-9a4b-4c3d-8e5f-0a1b2c3d4e5f"}
+
+	token := codec.Encode(cursor)
+	decoded, err := codec.Decode(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+	assert.NotContains(t, token, "filmes")
+}
+
+func TestGivenATamperedToken_WhenDecode_ThenShouldReceiveAnError(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	token := codec.Encode(pagination.Cursor{Sort: "name", Keys: []string{"a"}, ID: "1"})
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := pagination.NewCursorCodec([]byte("other")).Encode(pagination.Cursor{Sort: "name", Keys: []string{"z"}, ID: "9"})
+
+	for _, input := range []string{
+		"",
+		"not-a-cursor",
+		payload,
+		payload + "." + signature + "x",
+		strings.Split(forged, ".")[0] + "." + signature,
+		forged,
+	} {
+		_, err := codec.Decode(input)
+		assert.EqualError(t, err, "'cursor' is malformed or has been tampered with", input)
+	}
+}
+
+func TestGivenACursorQuery_WhenCallValidate_ThenShouldCheckSizeAndCursor(t *testing.T) {
+	cursor := &pagination.Cursor{Sort: "name", Direction: "asc"}
+
+	assert.NoError(t, pagination.CursorQuery{Size: 1, Sort: "name", Direction: "ASC", After: cursor}.Validate())
+	assert.EqualError(t, pagination.CursorQuery{Size: 0}.Validate(), "'size' must be greater than zero, got 0")
+	assert.Error(t, pagination.CursorQuery{Size: 1, Sort: "name", Direction: "asc", After: cursor, Before: cursor}.Validate())
+	assert.Error(t, pagination.CursorQuery{Size: 1, Sort: "created_at", Direction: "asc", Before: cursor}.Validate())
+}
+
+func TestGivenACursorRequest_WhenCallQuery_ThenShouldDecodeTokensAndApplyRules(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	rules := pagination.SearchRules{PerPage: 5, MaxPerPage: 20, Sorts: []string{"name", "created_at"}}
+	after := pagination.Cursor{Sort: "name", Direction: "asc", Keys: []string{"filmes"}, ID: "1"}
+
+	query, err := pagination.CursorRequest{Terms: " filmes ", After: codec.Encode(after)}.Query(codec, rules)
+
+	require.NoError(t, err)
+	assert.Equal(t, pagination.CursorQuery{Size: 5, Terms: "filmes", Sort: "name", Direction: "asc", After: &after}, query)
+
+	query, err = pagination.CursorRequest{Size: 50, Sort: "created_at", Direction: "DESC"}.Query(codec, rules)
+	require.NoError(t, err)
+	assert.Equal(t, pagination.CursorQuery{Size: 20, Sort: "created_at", Direction: "desc"}, query)
+
+	_, err = pagination.CursorRequest{Size: -1}.Query(codec, rules)
+	assert.EqualError(t, err, "'size' must not be negative, got -1")
+	_, err = pagination.CursorRequest{Before: "forged"}.Query(codec, rules)
+	assert.EqualError(t, err, "'cursor' is malformed or has been tampered with")
+	_, err = pagination.CursorRequest{Sort: "created_at", After: codec.Encode(after)}.Query(codec, rules)
+	assert.EqualError(t, err, "'cursor' belongs to a listing with a different sort or direction")
+}
+
+func TestGivenACursorPage_WhenCallEncodePage_ThenShouldSignItsCursors(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	next := pagination.Cursor{Sort: "name", Direction: "asc", Keys: []string{"b"}, ID: "b"}
+
+	page := pagination.EncodePage(codec, &pagination.CursorPage[string]{Size: 2, Items: []string{"a", "b"}, Next: &next}, strings.ToUpper)
+
+	assert.Equal(t, 2, page.Size)
+	assert.Equal(t, []string{"A", "B"}, page.Items)
+	assert.Empty(t, page.Prev)
+	decoded, err := codec.Decode(page.Next)
+	require.NoError(t, err)
+	assert.Equal(t, next, *decoded)
+}
+
+func TestGivenFetchedItems_WhenCallNewCursorPage_ThenShouldSetNeighbourCursors(t *testing.T) {
+	position := func(item *string) ([]string, string) { return []string{*item}, *item }
+	after := &pagination.Cursor{Keys: []string{"a"}, ID: "a"}
+
+	first := pagination.NewCursorPage(pagination.CursorQuery{Size: 2}, []string{"a", "b", "c"}, position)
+	assert.Equal(t, []string{"a", "b"}, first.Items)
+	assert.Nil(t, first.Prev)
+	assert.Equal(t, "b", first.Next.ID)
+
+	last := pagination.NewCursorPage(pagination.CursorQuery{Size: 2, After: after}, []string{"b"}, position)
+	assert.Equal(t, "b", last.Prev.ID)
+	assert.Nil(t, last.Next)
+
+	backward := pagination.NewCursorPage(pagination.CursorQuery{Size: 2, Before: after}, []string{"c", "b", "a"}, position)
+	assert.Equal(t, []string{"b", "c"}, backward.Items)
+	assert.Equal(t, "b", backward.Prev.ID)
+	assert.Equal(t, "c", backward.Next.ID)
+}
+
+func TestGivenKeys_WhenEncode_ThenStringOrderShouldMatchValueOrder(t *testing.T) {
+	earlier := time.Date(2024, 1, 1, 9, 0, 0, 5, time.FixedZone("BRT", -3*60*60))
+	later := time.Date(2024, 1, 1, 12, 0, 0, 10, time.UTC)
+
+	assert.Less(t, pagination.TimeKey(earlier), pagination.TimeKey(later))
+	assert.Less(t, pagination.IntKey(999), pagination.IntKey(1000))
+	assert.Equal(t, pagination.TextKey("filmes"), pagination.TextKey("FILMES"))
+
+	parsed, err := pagination.ParseTimeKey(pagination.TimeKey(later))
+	require.NoError(t, err)
+	assert.True(t, later.Equal(parsed))
+}
+
+func TestGivenPositions_WhenCallComparePosition_ThenIDShouldAlwaysBreakTiesAscending(t *testing.T) {
+	assert.Negative(t, pagination.ComparePosition([]string{"a"}, "2", []string{"b"}, "1", false))
+	assert.Positive(t, pagination.ComparePosition([]string{"a"}, "2", []string{"b"}, "1", true))
+	assert.Negative(t, pagination.ComparePosition([]string{"a"}, "1", []string{"a"}, "2", true))
+}
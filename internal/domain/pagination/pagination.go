@@ -1,22 +1,108 @@
 package pagination
 
+import (
+	"fmt"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
 type Pagination[T any] struct {
-	CurrentPage int   `json:"current_page"`
-	PerPage     int   `json:"per_page"`
-	Total       int64 `json:"total"`
-	Items       []T   `json:"items"`
+	CurrentPage int    `json:"current_page"`
+	PerPage     int    `json:"per_page"`
+	Total       int64  `json:"total"`
+	TotalPages  int64  `json:"total_pages"`
+	HasNext     bool   `json:"has_next"`
+	HasPrevious bool   `json:"has_previous"`
+	IsLast      bool   `json:"is_last"`
+	Links       *Links `json:"links,omitempty"`
+	Items       []T    `json:"items"`
+}
+
+// Links points at neighbouring pages of the same search. Prev and Next are
+// empty when there is no such page.
+type Links struct {
+	Self  string `json:"self"`
+	First string `json:"first"`
+	Prev  string `json:"prev,omitempty"`
+	Next  string `json:"next,omitempty"`
+	Last  string `json:"last"`
+}
+
+// New builds the page of items the query asked for out of total matches and
+// derives the navigation fields from them.
+func New[T any](query SearchQuery, total int64, items []T) (*Pagination[T], error) {
+	notification := validation.NewNotification()
+	if query.Page < 0 {
+		notification.Append("page", validation.CodeRange, fmt.Sprintf("'page' must not be negative, got %d", query.Page))
+	}
+	if query.PerPage < 0 {
+		notification.Append("perPage", validation.CodeRange, fmt.Sprintf("'perPage' must not be negative, got %d", query.PerPage))
+	}
+	if total < 0 {
+		notification.Append("total", validation.CodeRange, fmt.Sprintf("'total' must not be negative, got %d", total))
+	}
+	if len(items) > max(query.PerPage, 0) || int64(len(items)) > max(total, 0) {
+		notification.Append("items", validation.CodeRange,
+			fmt.Sprintf("a page cannot hold %d items with 'perPage' %d and 'total' %d", len(items), query.PerPage, total))
+	}
+	if err := notification.Err(); err != nil {
+		return nil, err
+	}
+
+	if items == nil {
+		items = []T{}
+	}
+	p := &Pagination[T]{
+		CurrentPage: query.Page,
+		PerPage:     query.PerPage,
+		Total:       total,
+		Items:       items,
+	}
+	if query.PerPage > 0 {
+		p.TotalPages = (total + int64(query.PerPage) - 1) / int64(query.PerPage)
+	}
+	p.HasNext = int64(query.Page) < p.TotalPages-1
+	p.HasPrevious = query.Page > 0
+	p.IsLast = !p.HasNext
+	return p, nil
+}
+
+// WithLinks fills Links using link to render the address of a page number.
+func (p *Pagination[T]) WithLinks(link func(page int) string) *Pagination[T] {
+	last := max(int(p.TotalPages)-1, 0)
+	p.Links = &Links{
+		Self:  link(p.CurrentPage),
+		First: link(0),
+		Last:  link(last),
+	}
+	if p.HasPrevious {
+		p.Links.Prev = link(min(p.CurrentPage-1, last))
+	}
+	if p.HasNext {
+		p.Links.Next = link(p.CurrentPage + 1)
+	}
+	return p
 }
 
 func (p *Pagination[T]) Map(mapper func(T) any) *Pagination[any] {
-	newItems := make([]any, len(p.Items))
+	return MapItems(p, mapper)
+}
+
+func MapItems[T, R any](p *Pagination[T], mapper func(T) R) *Pagination[R] {
+	newItems := make([]R, len(p.Items))
 	for i, item := range p.Items {
 		newItems[i] = mapper(item)
 	}
 
-	return &Pagination[any]{
+	return &Pagination[R]{
 		CurrentPage: p.CurrentPage,
 		PerPage:     p.PerPage,
 		Total:       p.Total,
+		TotalPages:  p.TotalPages,
+		HasNext:     p.HasNext,
+		HasPrevious: p.HasPrevious,
+		IsLast:      p.IsLast,
+		Links:       p.Links,
 		Items:       newItems,
 	}
 }
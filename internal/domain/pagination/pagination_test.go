@@ -0,0 +1,101 @@
+package pagination_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+func TestGivenAMiddlePage_WhenCallNew_ThenShouldDeriveNavigationFields(t *testing.T) {
+	p, err := pagination.New(pagination.SearchQuery{Page: 1, PerPage: 2}, 5, []string{"c", "d"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), p.TotalPages)
+	assert.True(t, p.HasNext)
+	assert.True(t, p.HasPrevious)
+	assert.False(t, p.IsLast)
+}
+
+func TestGivenEdgePages_WhenCallNew_ThenShouldFlagFirstAndLast(t *testing.T) {
+	for _, tc := range []struct {
+		name                       string
+		query                      pagination.SearchQuery
+		total                      int64
+		items                      []string
+		totalPages                 int64
+		hasNext, hasPrevious, last bool
+	}{
+		{"only page", pagination.SearchQuery{Page: 0, PerPage: 10}, 3, []string{"a", "b", "c"}, 1, false, false, true},
+		{"last page", pagination.SearchQuery{Page: 2, PerPage: 2}, 5, []string{"e"}, 3, false, true, true},
+		{"past the end", pagination.SearchQuery{Page: 7, PerPage: 2}, 5, nil, 3, false, true, true},
+		{"no results", pagination.SearchQuery{Page: 0, PerPage: 10}, 0, nil, 0, false, false, true},
+		{"zero per page", pagination.SearchQuery{Page: 0, PerPage: 0}, 5, nil, 0, false, false, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := pagination.New(tc.query, tc.total, tc.items)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.totalPages, p.TotalPages)
+			assert.Equal(t, tc.hasNext, p.HasNext)
+			assert.Equal(t, tc.hasPrevious, p.HasPrevious)
+			assert.Equal(t, tc.last, p.IsLast)
+			assert.NotNil(t, p.Items)
+		})
+	}
+}
+
+func TestGivenInvalidInputs_WhenCallNew_ThenShouldReceiveEveryError(t *testing.T) {
+	_, err := pagination.New(pagination.SearchQuery{Page: -1, PerPage: -2}, -3, []string{"a"})
+
+	assert.EqualError(t, err, "'page' must not be negative, got -1; "+
+		"'perPage' must not be negative, got -2; "+
+		"'total' must not be negative, got -3; "+
+		"a page cannot hold 1 items with 'perPage' -2 and 'total' -3")
+}
+
+func TestGivenMoreItemsThanPerPage_WhenCallNew_ThenShouldReceiveAnError(t *testing.T) {
+	_, err := pagination.New(pagination.SearchQuery{PerPage: 1}, 10, []string{"a", "b"})
+
+	assert.Error(t, err)
+}
+
+func TestGivenAPage_WhenCallWithLinks_ThenShouldLinkToNeighbours(t *testing.T) {
+	p, err := pagination.New(pagination.SearchQuery{Page: 1, PerPage: 2}, 5, []string{"c", "d"})
+	require.NoError(t, err)
+
+	p.WithLinks(func(page int) string { return "/items?page=" + strconv.Itoa(page) })
+
+	assert.Equal(t, &pagination.Links{
+		Self:  "/items?page=1",
+		First: "/items?page=0",
+		Prev:  "/items?page=0",
+		Next:  "/items?page=2",
+		Last:  "/items?page=2",
+	}, p.Links)
+}
+
+func TestGivenAPagePastTheEnd_WhenCallWithLinks_ThenPrevShouldPointAtTheLastPage(t *testing.T) {
+	p, err := pagination.New[string](pagination.SearchQuery{Page: 9, PerPage: 2}, 5, nil)
+	require.NoError(t, err)
+
+	p.WithLinks(func(page int) string { return strconv.Itoa(page) })
+
+	assert.Equal(t, "2", p.Links.Prev)
+	assert.Empty(t, p.Links.Next)
+}
+
+func TestGivenAPagination_WhenCallMapItems_ThenShouldKeepMetadata(t *testing.T) {
+	p, err := pagination.New(pagination.SearchQuery{Page: 0, PerPage: 2}, 3, []int{1, 2})
+	require.NoError(t, err)
+	p.WithLinks(func(page int) string { return strconv.Itoa(page) })
+
+	mapped := pagination.MapItems(p, strconv.Itoa)
+
+	assert.Equal(t, []string{"1", "2"}, mapped.Items)
+	assert.Equal(t, p.TotalPages, mapped.TotalPages)
+	assert.Equal(t, p.HasNext, mapped.HasNext)
+	assert.Equal(t, p.Links, mapped.Links)
+}
@@ -1,9 +1,135 @@
 package pagination
 
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+const (
+	DefaultPerPage    = 10
+	DefaultMaxPerPage = 100
+
+	Asc  = "asc"
+	Desc = "desc"
+)
+
 type SearchQuery struct {
 	Page      int
 	PerPage   int
 	Terms     string
 	Sort      string
 	Direction string
+	Filters   map[string]string
+}
+
+// SearchRules is what a listing accepts. The first entry of Sorts is the
+// default sort; zero PerPage and MaxPerPage fall back to the package defaults.
+type SearchRules struct {
+	Sorts      []string
+	Filters    []string
+	PerPage    int
+	MaxPerPage int
+}
+
+// Normalize fills in defaults, clamps PerPage to the rules' maximum and
+// validates the rest, reporting every problem at once.
+func (q SearchQuery) Normalize(rules SearchRules) (SearchQuery, error) {
+	perPage := cmp.Or(rules.PerPage, DefaultPerPage)
+	maxPerPage := cmp.Or(rules.MaxPerPage, DefaultMaxPerPage)
+
+	normalized := SearchQuery{
+		Page:      q.Page,
+		PerPage:   min(cmp.Or(q.PerPage, perPage), maxPerPage),
+		Terms:     strings.TrimSpace(q.Terms),
+		Sort:      strings.TrimSpace(q.Sort),
+		Direction: strings.ToLower(strings.TrimSpace(q.Direction)),
+		Filters:   q.Filters,
+	}
+	if normalized.Sort == "" && len(rules.Sorts) > 0 {
+		normalized.Sort = rules.Sorts[0]
+	}
+	if normalized.Direction == "" {
+		normalized.Direction = Asc
+	}
+
+	notification := validation.NewNotification()
+	if q.Page < 0 {
+		notification.Append("page", validation.CodeRange, fmt.Sprintf("'page' must not be negative, got %d", q.Page))
+	}
+	if normalized.PerPage > 0 && q.Page > math.MaxInt/normalized.PerPage {
+		notification.Append("page", validation.CodeRange,
+			fmt.Sprintf("'page' must not exceed %d with 'perPage' %d, got %d", math.MaxInt/normalized.PerPage, normalized.PerPage, q.Page))
+	}
+	if q.PerPage < 0 {
+		notification.Append("perPage", validation.CodeRange, fmt.Sprintf("'perPage' must not be negative, got %d", q.PerPage))
+	}
+	checkSort(notification, normalized.Sort, rules.Sorts)
+	checkDirection(notification, normalized.Direction)
+	checkFilters(notification, normalized.Filters, rules.Filters)
+	if err := notification.Err(); err != nil {
+		return SearchQuery{}, err
+	}
+	return normalized, nil
+}
+
+func (q SearchQuery) IsDescending() (bool, error) {
+	direction := strings.ToLower(q.Direction)
+	if err := checkDirection(validation.NewNotification(), direction).Err(); err != nil {
+		return false, err
+	}
+	return direction == Desc, nil
+}
+
+// CheckSort accepts an empty Sort, meaning the listing's default, or one of
+// allowed.
+func (q SearchQuery) CheckSort(allowed ...string) error {
+	return checkSort(validation.NewNotification(), q.Sort, allowed).Err()
+}
+
+func (q SearchQuery) CheckFilters(allowed ...string) error {
+	return checkFilters(validation.NewNotification(), q.Filters, allowed).Err()
+}
+
+func checkSort(notification *validation.Notification, sort string, allowed []string) *validation.Notification {
+	if sort == "" || slices.Contains(allowed, sort) {
+		return notification
+	}
+	quoted := make([]string, len(allowed))
+	for i, name := range allowed {
+		quoted[i] = "'" + name + "'"
+	}
+	return notification.Append("sort", validation.CodeInvalid, fmt.Sprintf("'sort' must be one of %s, got '%s'", joinOr(quoted), sort))
+}
+
+func checkDirection(notification *validation.Notification, direction string) *validation.Notification {
+	switch direction {
+	case "", Asc, Desc:
+		return notification
+	default:
+		return notification.Append("direction", validation.CodeInvalid, fmt.Sprintf("'direction' must be either 'asc' or 'desc', got '%s'", direction))
+	}
+}
+
+func checkFilters(notification *validation.Notification, filters map[string]string, allowed []string) *validation.Notification {
+	keys := slices.Sorted(maps.Keys(filters))
+	for _, key := range keys {
+		if !slices.Contains(allowed, key) {
+			notification.Append(key, validation.CodeInvalid, fmt.Sprintf("'%s' is not a supported filter", key))
+		}
+	}
+	return notification
+}
+
+// joinOr renders ["a", "b", "c"] as "a, b or c".
+func joinOr(values []string) string {
+	if len(values) <= 1 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
 }
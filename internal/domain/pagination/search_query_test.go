@@ -0,0 +1,82 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+func TestGivenADirection_WhenCallIsDescending_ThenShouldAcceptOnlyAscOrDesc(t *testing.T) {
+	for direction, want := range map[string]bool{"": false, "asc": false, "DESC": true} {
+		desc, err := pagination.SearchQuery{Direction: direction}.IsDescending()
+		assert.NoError(t, err)
+		assert.Equal(t, want, desc, direction)
+	}
+
+	_, err := pagination.SearchQuery{Direction: "up"}.IsDescending()
+	assert.EqualError(t, err, "'direction' must be either 'asc' or 'desc', got 'up'")
+}
+
+func TestGivenFilters_WhenCallCheckFilters_ThenShouldRejectUnsupportedKeys(t *testing.T) {
+	query := pagination.SearchQuery{Filters: map[string]string{"type": "ACTOR"}}
+
+	assert.NoError(t, query.CheckFilters("type"))
+	assert.EqualError(t, query.CheckFilters(), "'type' is not a supported filter")
+}
+
+var rules = pagination.SearchRules{Sorts: []string{"name", "created_at"}, Filters: []string{"type"}}
+
+func TestGivenAnEmptyQuery_WhenCallNormalize_ThenShouldApplyDefaults(t *testing.T) {
+	query, err := pagination.SearchQuery{Terms: "  filmes "}.Normalize(rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, pagination.SearchQuery{PerPage: 10, Terms: "filmes", Sort: "name", Direction: "asc"}, query)
+}
+
+func TestGivenAnOversizedPerPage_WhenCallNormalize_ThenShouldClampToTheMax(t *testing.T) {
+	query, err := pagination.SearchQuery{PerPage: 1000, Direction: "DESC"}.Normalize(rules)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, query.PerPage)
+	assert.Equal(t, "desc", query.Direction)
+
+	custom := pagination.SearchRules{Sorts: rules.Sorts, PerPage: 5, MaxPerPage: 20}
+	query, err = pagination.SearchQuery{}.Normalize(custom)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, query.PerPage)
+	query, err = pagination.SearchQuery{PerPage: 50}.Normalize(custom)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, query.PerPage)
+}
+
+func TestGivenAnInvalidQuery_WhenCallNormalize_ThenShouldReportEveryProblem(t *testing.T) {
+	_, err := pagination.SearchQuery{
+		Page:      -1,
+		PerPage:   -5,
+		Sort:      "password",
+		Direction: "sideways",
+		Filters:   map[string]string{"age": "30"},
+	}.Normalize(rules)
+
+	assert.EqualError(t, err, "'page' must not be negative, got -1; "+
+		"'perPage' must not be negative, got -5; "+
+		"'sort' must be one of 'name' or 'created_at', got 'password'; "+
+		"'direction' must be either 'asc' or 'desc', got 'sideways'; "+
+		"'age' is not a supported filter")
+}
+
+func TestGivenAPageBeyondTheOffsetRange_WhenCallNormalize_ThenShouldReportAPageRangeError(t *testing.T) {
+	_, err := pagination.SearchQuery{Page: 922337203685477581}.Normalize(rules)
+	assert.EqualError(t, err, "'page' must not exceed 922337203685477580 with 'perPage' 10, got 922337203685477581")
+
+	query, err := pagination.SearchQuery{Page: 922337203685477580}.Normalize(rules)
+	assert.NoError(t, err)
+	assert.Equal(t, 922337203685477580, query.Page)
+}
+
+func TestGivenASort_WhenCallCheckSort_ThenShouldAcceptOnlyTheWhitelist(t *testing.T) {
+	assert.NoError(t, pagination.SearchQuery{}.CheckSort("name"))
+	assert.NoError(t, pagination.SearchQuery{Sort: "name"}.CheckSort("name", "type"))
+	assert.EqualError(t, pagination.SearchQuery{Sort: "age"}.CheckSort("name", "type", "created_at"),
+		"'sort' must be one of 'name', 'type' or 'created_at', got 'age'")
+}
@@ -0,0 +1,70 @@
+package validation
+
+import "strings"
+
+const (
+	CodeRequired = "required"
+	CodeLength   = "length"
+	CodeRange    = "range"
+	CodeInvalid  = "invalid"
+)
+
+type FieldError struct {
+	Field   string
+	Code    string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Message
+}
+
+type Notification struct {
+	errors []FieldError
+}
+
+func NewNotification() *Notification {
+	return &Notification{}
+}
+
+func (n *Notification) Append(field, code, message string) *Notification {
+	n.errors = append(n.errors, FieldError{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	})
+	return n
+}
+
+func (n *Notification) HasErrors() bool {
+	return len(n.errors) > 0
+}
+
+func (n *Notification) Errors() []FieldError {
+	return append([]FieldError(nil), n.errors...)
+}
+
+func (n *Notification) FieldErrors(field string) []FieldError {
+	var errs []FieldError
+	for _, err := range n.errors {
+		if err.Field == field {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (n *Notification) Error() string {
+	msgs := make([]string, len(n.errors))
+	for i, err := range n.errors {
+		msgs[i] = err.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (n *Notification) Err() error {
+	if !n.HasErrors() {
+		return nil
+	}
+	return n
+}
@@ -0,0 +1,46 @@
+package validation_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+func TestGivenAnEmptyNotification_WhenCallErr_ThenShouldReturnNil(t *testing.T) {
+	notification := validation.NewNotification()
+
+	assert.False(t, notification.HasErrors())
+	assert.Empty(t, notification.Errors())
+	assert.NoError(t, notification.Err())
+}
+
+func TestGivenManyFieldErrors_WhenCallErr_ThenShouldAccumulateAll(t *testing.T) {
+	notification := validation.NewNotification().
+		Append("id", validation.CodeRequired, "'id' should not be empty").
+		Append("name", validation.CodeLength, "'name' must be between 3 and 255 characters")
+
+	err := notification.Err()
+
+	assert.Error(t, err)
+	assert.True(t, notification.HasErrors())
+	assert.Equal(t, "'id' should not be empty; 'name' must be between 3 and 255 characters", err.Error())
+	assert.Equal(t, []validation.FieldError{
+		{Field: "id", Code: validation.CodeRequired, Message: "'id' should not be empty"},
+		{Field: "name", Code: validation.CodeLength, Message: "'name' must be between 3 and 255 characters"},
+	}, notification.Errors())
+	assert.Len(t, notification.FieldErrors("name"), 1)
+	assert.Empty(t, notification.FieldErrors("description"))
+}
+
+func TestGivenAWrappedNotification_WhenCallErrorsAs_ThenShouldExposeTheFieldErrors(t *testing.T) {
+	notification := validation.NewNotification().
+		Append("name", validation.CodeRequired, "'name' should not be empty")
+	err := fmt.Errorf("create category: %w", notification.Err())
+
+	var target *validation.Notification
+	assert.True(t, errors.As(err, &target))
+	assert.Equal(t, "name", target.Errors()[0].Field)
+}
@@ -0,0 +1,136 @@
+package video
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+type MediaStatus string
+
+const (
+	MediaStatusPending    MediaStatus = "PENDING"
+	MediaStatusProcessing MediaStatus = "PROCESSING"
+	MediaStatusCompleted  MediaStatus = "COMPLETED"
+	MediaStatusError      MediaStatus = "ERROR"
+)
+
+var mediaStatusTransitions = map[MediaStatus][]MediaStatus{
+	MediaStatusPending:    {MediaStatusProcessing, MediaStatusError},
+	MediaStatusProcessing: {MediaStatusCompleted, MediaStatusError},
+	MediaStatusError:      {MediaStatusProcessing},
+	MediaStatusCompleted:  {},
+}
+
+func (s MediaStatus) CanTransitionTo(next MediaStatus) bool {
+	return slices.Contains(mediaStatusTransitions[s], next)
+}
+
+type MediaType string
+
+const (
+	MediaTypeVideo         MediaType = "VIDEO"
+	MediaTypeTrailer       MediaType = "TRAILER"
+	MediaTypeBanner        MediaType = "BANNER"
+	MediaTypeThumbnail     MediaType = "THUMBNAIL"
+	MediaTypeThumbnailHalf MediaType = "THUMBNAIL_HALF"
+)
+
+type ImageMedia struct {
+	Checksum string
+	Name     string
+	Location string
+}
+
+func NewImageMedia(checksum, name, location string) (ImageMedia, error) {
+	media := ImageMedia{
+		Checksum: checksum,
+		Name:     name,
+		Location: location,
+	}
+	if err := media.IsValid(); err != nil {
+		return ImageMedia{}, err
+	}
+	return media, nil
+}
+
+func (m ImageMedia) IsValid() error {
+	notification := validation.NewNotification()
+	if m.Checksum == "" {
+		notification.Append("checksum", validation.CodeRequired, "'checksum' should not be empty")
+	}
+	if m.Name == "" {
+		notification.Append("name", validation.CodeRequired, "'name' should not be empty")
+	}
+	if m.Location == "" {
+		notification.Append("location", validation.CodeRequired, "'location' should not be empty")
+	}
+	return notification.Err()
+}
+
+type AudioVideoMedia struct {
+	Checksum        string
+	Name            string
+	RawLocation     string
+	EncodedLocation string
+	Status          MediaStatus
+}
+
+func NewAudioVideoMedia(checksum, name, rawLocation string) (AudioVideoMedia, error) {
+	media := AudioVideoMedia{
+		Checksum:    checksum,
+		Name:        name,
+		RawLocation: rawLocation,
+		Status:      MediaStatusPending,
+	}
+	if err := media.IsValid(); err != nil {
+		return AudioVideoMedia{}, err
+	}
+	return media, nil
+}
+
+func (m AudioVideoMedia) Processing() (AudioVideoMedia, error) {
+	return m.transitionTo(MediaStatusProcessing, "")
+}
+
+func (m AudioVideoMedia) Completed(encodedLocation string) (AudioVideoMedia, error) {
+	if encodedLocation == "" {
+		return m, validation.NewNotification().
+			Append("encodedLocation", validation.CodeRequired, "'encodedLocation' should not be empty")
+	}
+	return m.transitionTo(MediaStatusCompleted, encodedLocation)
+}
+
+func (m AudioVideoMedia) Failed() (AudioVideoMedia, error) {
+	return m.transitionTo(MediaStatusError, "")
+}
+
+func (m AudioVideoMedia) transitionTo(next MediaStatus, encodedLocation string) (AudioVideoMedia, error) {
+	if !m.Status.CanTransitionTo(next) {
+		return m, VideoError{fmt.Sprintf(
+			"media status cannot change from '%s' to '%s'",
+			m.Status, next,
+		)}
+	}
+	m.Status = next
+	m.EncodedLocation = encodedLocation
+	return m, nil
+}
+
+func (m AudioVideoMedia) IsValid() error {
+	notification := validation.NewNotification()
+	if m.Checksum == "" {
+		notification.Append("checksum", validation.CodeRequired, "'checksum' should not be empty")
+	}
+	if m.Name == "" {
+		notification.Append("name", validation.CodeRequired, "'name' should not be empty")
+	}
+	if m.RawLocation == "" {
+		notification.Append("rawLocation", validation.CodeRequired, "'rawLocation' should not be empty")
+	}
+	if _, ok := mediaStatusTransitions[m.Status]; !ok {
+		notification.Append("status", validation.CodeInvalid, "'status' must be one of 'PENDING', 'PROCESSING', 'COMPLETED' or 'ERROR'")
+	}
+	return notification.Err()
+}
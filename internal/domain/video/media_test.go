@@ -0,0 +1,145 @@
+package video_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/video"
+)
+
+func newTrailer(t *testing.T) video.AudioVideoMedia {
+	t.Helper()
+	media, err := video.NewAudioVideoMedia("abc123", "trailer.mp4", "/raw/trailer.mp4")
+	require.NoError(t, err)
+	return media
+}
+
+func TestGivenValidParams_WhenCallNewImageMedia_ThenInstantiateAnImageMedia(t *testing.T) {
+	media, err := video.NewImageMedia("abc123", "banner.png", "/images/banner.png")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", media.Checksum)
+	assert.Equal(t, "banner.png", media.Name)
+	assert.Equal(t, "/images/banner.png", media.Location)
+}
+
+func TestGivenEmptyParams_WhenCallNewImageMedia_ThenShouldReceiveEveryError(t *testing.T) {
+	_, err := video.NewImageMedia("", "", "")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'checksum' should not be empty")
+	assert.Contains(t, err.Error(), "'name' should not be empty")
+	assert.Contains(t, err.Error(), "'location' should not be empty")
+}
+
+func TestGivenValidParams_WhenCallNewAudioVideoMedia_ThenShouldStartPending(t *testing.T) {
+	media := newTrailer(t)
+
+	assert.Equal(t, video.MediaStatusPending, media.Status)
+	assert.Empty(t, media.EncodedLocation)
+}
+
+func TestGivenAPendingMedia_WhenFollowTheEncodingLifecycle_ThenShouldReachCompleted(t *testing.T) {
+	media := newTrailer(t)
+
+	processing, err := media.Processing()
+	assert.NoError(t, err)
+	assert.Equal(t, video.MediaStatusProcessing, processing.Status)
+	assert.Equal(t, video.MediaStatusPending, media.Status)
+
+	completed, err := processing.Completed("/encoded/trailer.mp4")
+	assert.NoError(t, err)
+	assert.Equal(t, video.MediaStatusCompleted, completed.Status)
+	assert.Equal(t, "/encoded/trailer.mp4", completed.EncodedLocation)
+}
+
+func TestGivenAMediaStatus_WhenCallCanTransitionTo_ThenShouldOnlyAllowLegalTransitions(t *testing.T) {
+	cases := []struct {
+		from, to video.MediaStatus
+		allowed  bool
+	}{
+		{video.MediaStatusPending, video.MediaStatusProcessing, true},
+		{video.MediaStatusPending, video.MediaStatusError, true},
+		{video.MediaStatusPending, video.MediaStatusCompleted, false},
+		{video.MediaStatusProcessing, video.MediaStatusCompleted, true},
+		{video.MediaStatusProcessing, video.MediaStatusError, true},
+		{video.MediaStatusProcessing, video.MediaStatusPending, false},
+		{video.MediaStatusError, video.MediaStatusProcessing, true},
+		{video.MediaStatusError, video.MediaStatusCompleted, false},
+		{video.MediaStatusCompleted, video.MediaStatusProcessing, false},
+		{video.MediaStatusCompleted, video.MediaStatusError, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.allowed, c.from.CanTransitionTo(c.to), "%s -> %s", c.from, c.to)
+	}
+}
+
+func TestGivenAPendingMedia_WhenCallCompleted_ThenShouldReceiveAnError(t *testing.T) {
+	media := newTrailer(t)
+
+	_, err := media.Completed("/encoded/trailer.mp4")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "media status cannot change from 'PENDING' to 'COMPLETED'")
+}
+
+func TestGivenAProcessingMedia_WhenCallCompletedWithoutLocation_ThenShouldReceiveAnError(t *testing.T) {
+	media, err := newTrailer(t).Processing()
+	require.NoError(t, err)
+
+	_, err = media.Completed("")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'encodedLocation' should not be empty")
+}
+
+func TestGivenAVideo_WhenCallSetImageMedias_ThenShouldAttachThem(t *testing.T) {
+	videoEntity := newValidVideo(t)
+	banner, err := video.NewImageMedia("b1", "banner.png", "/images/banner.png")
+	require.NoError(t, err)
+	replacement, err := video.NewImageMedia("b2", "banner-v2.png", "/images/banner-v2.png")
+	require.NoError(t, err)
+
+	assert.NoError(t, videoEntity.SetBanner(nil, banner))
+	assert.NoError(t, videoEntity.SetThumbnail(nil, banner))
+	assert.NoError(t, videoEntity.SetThumbnailHalf(nil, banner))
+	assert.NoError(t, videoEntity.SetBanner(nil, replacement))
+
+	assert.Equal(t, &replacement, videoEntity.Banner)
+	assert.Equal(t, &banner, videoEntity.Thumbnail)
+	assert.Equal(t, &banner, videoEntity.ThumbnailHalf)
+	assert.Error(t, videoEntity.SetBanner(nil, video.ImageMedia{}))
+}
+
+func TestGivenAVideoWithTrailer_WhenCallMediaLifecycleMethods_ThenShouldUpdateStatus(t *testing.T) {
+	videoEntity := newValidVideo(t)
+	assert.NoError(t, videoEntity.SetTrailer(nil, newTrailer(t)))
+
+	assert.NoError(t, videoEntity.ProcessingMedia(nil, video.MediaTypeTrailer))
+	assert.Equal(t, video.MediaStatusProcessing, videoEntity.Trailer.Status)
+
+	assert.NoError(t, videoEntity.FailMedia(nil, video.MediaTypeTrailer))
+	assert.Equal(t, video.MediaStatusError, videoEntity.Trailer.Status)
+
+	assert.NoError(t, videoEntity.ProcessingMedia(nil, video.MediaTypeTrailer))
+	assert.NoError(t, videoEntity.CompleteMedia(nil, video.MediaTypeTrailer, "/encoded/trailer.mp4"))
+	assert.Equal(t, video.MediaStatusCompleted, videoEntity.Trailer.Status)
+	assert.Equal(t, "/encoded/trailer.mp4", videoEntity.Trailer.EncodedLocation)
+
+	err := videoEntity.ProcessingMedia(nil, video.MediaTypeTrailer)
+	assert.Error(t, err)
+	assert.Equal(t, video.MediaStatusCompleted, videoEntity.Trailer.Status)
+}
+
+func TestGivenAVideoWithoutMedia_WhenCallMediaLifecycleMethods_ThenShouldReceiveAnError(t *testing.T) {
+	videoEntity := newValidVideo(t)
+
+	err := videoEntity.ProcessingMedia(nil, video.MediaTypeVideo)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "video has no 'VIDEO' media")
+
+	err = videoEntity.CompleteMedia(nil, video.MediaTypeBanner, "/encoded/banner.png")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'BANNER' is not an audio/video media")
+}
@@ -0,0 +1,49 @@
+package video
+
+import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+
+type Rating string
+
+const invalidRatingMessage = "'rating' must be one of 'ER', 'L', 'AGE_10', 'AGE_12', 'AGE_14', 'AGE_16' or 'AGE_18'"
+
+const (
+	RatingER    Rating = "ER"
+	RatingL     Rating = "L"
+	RatingAge10 Rating = "AGE_10"
+	RatingAge12 Rating = "AGE_12"
+	RatingAge14 Rating = "AGE_14"
+	RatingAge16 Rating = "AGE_16"
+	RatingAge18 Rating = "AGE_18"
+)
+
+var ratings = []Rating{
+	RatingER,
+	RatingL,
+	RatingAge10,
+	RatingAge12,
+	RatingAge14,
+	RatingAge16,
+	RatingAge18,
+}
+
+func Ratings() []Rating {
+	return append([]Rating(nil), ratings...)
+}
+
+func ParseRating(value string) (Rating, error) {
+	rating := Rating(value)
+	if !rating.IsValid() {
+		return "", validation.NewNotification().
+			Append("rating", validation.CodeInvalid, invalidRatingMessage)
+	}
+	return rating, nil
+}
+
+func (r Rating) IsValid() bool {
+	for _, rating := range ratings {
+		if r == rating {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,302 @@
+package video
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/genre"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/identifier"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+type VideoError struct {
+	msg string
+}
+
+func (v VideoError) Error() string {
+	return v.msg
+}
+
+var (
+	ErrVideoNotFound      = VideoError{"video not found"}
+	ErrVideoAlreadyExists = VideoError{"video already exists"}
+)
+
+type VideoID = identifier.ID[Video]
+
+func NewVideoID() VideoID {
+	return identifier.New[Video]()
+}
+
+func ParseVideoID(value string) (VideoID, error) {
+	return identifier.Parse[Video](value)
+}
+
+type Video struct {
+	ID            VideoID
+	Title         string
+	Description   string
+	LaunchYear    int
+	Duration      time.Duration
+	Opened        bool
+	Published     bool
+	Rating        Rating
+	CategoryIDs   []category.CategoryID
+	GenreIDs      []genre.GenreID
+	CastMemberIDs []castmember.CastMemberID
+	Banner        *ImageMedia
+	Thumbnail     *ImageMedia
+	ThumbnailHalf *ImageMedia
+	Trailer       *AudioVideoMedia
+	VideoMedia    *AudioVideoMedia
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewVideo and the methods that change a video read the time from clock, or
+// from the system clock when it is nil.
+func NewVideo(
+	clock timeutils.Clock,
+	title, description string,
+	launchYear int,
+	duration time.Duration,
+	opened, published bool,
+	rating Rating,
+	categoryIDs []category.CategoryID,
+	genreIDs []genre.GenreID,
+	castMemberIDs []castmember.CastMemberID,
+) (*Video, error) {
+	now := timeutils.Now(clock)
+	video := &Video{
+		ID:            NewVideoID(),
+		Title:         title,
+		Description:   description,
+		LaunchYear:    launchYear,
+		Duration:      duration,
+		Opened:        opened,
+		Published:     published,
+		Rating:        rating,
+		CategoryIDs:   uniqueIDs(categoryIDs),
+		GenreIDs:      uniqueIDs(genreIDs),
+		CastMemberIDs: uniqueIDs(castMemberIDs),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := video.IsValid(); err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+func (v *Video) Update(
+	clock timeutils.Clock,
+	title, description string,
+	launchYear int,
+	duration time.Duration,
+	opened, published bool,
+	rating Rating,
+	categoryIDs []category.CategoryID,
+	genreIDs []genre.GenreID,
+	castMemberIDs []castmember.CastMemberID,
+) error {
+	v.Title = title
+	v.Description = description
+	v.LaunchYear = launchYear
+	v.Duration = duration
+	v.Opened = opened
+	v.Published = published
+	v.Rating = rating
+	v.CategoryIDs = uniqueIDs(categoryIDs)
+	v.GenreIDs = uniqueIDs(genreIDs)
+	v.CastMemberIDs = uniqueIDs(castMemberIDs)
+	v.UpdatedAt = timeutils.Now(clock)
+	return v.IsValid()
+}
+
+func (v *Video) AddCategory(clock timeutils.Clock, categoryID category.CategoryID) {
+	v.CategoryIDs = addID(clock, v, v.CategoryIDs, categoryID)
+}
+
+func (v *Video) RemoveCategory(clock timeutils.Clock, categoryID category.CategoryID) {
+	v.CategoryIDs = removeID(clock, v, v.CategoryIDs, categoryID)
+}
+
+func (v *Video) AddGenre(clock timeutils.Clock, genreID genre.GenreID) {
+	v.GenreIDs = addID(clock, v, v.GenreIDs, genreID)
+}
+
+func (v *Video) RemoveGenre(clock timeutils.Clock, genreID genre.GenreID) {
+	v.GenreIDs = removeID(clock, v, v.GenreIDs, genreID)
+}
+
+func (v *Video) AddCastMember(clock timeutils.Clock, castMemberID castmember.CastMemberID) {
+	v.CastMemberIDs = addID(clock, v, v.CastMemberIDs, castMemberID)
+}
+
+func (v *Video) RemoveCastMember(clock timeutils.Clock, castMemberID castmember.CastMemberID) {
+	v.CastMemberIDs = removeID(clock, v, v.CastMemberIDs, castMemberID)
+}
+
+func (v *Video) SetBanner(clock timeutils.Clock, media ImageMedia) error {
+	return v.setImageMedia(clock, &v.Banner, media)
+}
+
+func (v *Video) SetThumbnail(clock timeutils.Clock, media ImageMedia) error {
+	return v.setImageMedia(clock, &v.Thumbnail, media)
+}
+
+func (v *Video) SetThumbnailHalf(clock timeutils.Clock, media ImageMedia) error {
+	return v.setImageMedia(clock, &v.ThumbnailHalf, media)
+}
+
+func (v *Video) SetTrailer(clock timeutils.Clock, media AudioVideoMedia) error {
+	return v.setAudioVideoMedia(clock, &v.Trailer, media)
+}
+
+func (v *Video) SetVideoMedia(clock timeutils.Clock, media AudioVideoMedia) error {
+	return v.setAudioVideoMedia(clock, &v.VideoMedia, media)
+}
+
+func (v *Video) ProcessingMedia(clock timeutils.Clock, mediaType MediaType) error {
+	return v.updateAudioVideoMedia(clock, mediaType, AudioVideoMedia.Processing)
+}
+
+func (v *Video) CompleteMedia(clock timeutils.Clock, mediaType MediaType, encodedLocation string) error {
+	return v.updateAudioVideoMedia(clock, mediaType, func(media AudioVideoMedia) (AudioVideoMedia, error) {
+		return media.Completed(encodedLocation)
+	})
+}
+
+func (v *Video) FailMedia(clock timeutils.Clock, mediaType MediaType) error {
+	return v.updateAudioVideoMedia(clock, mediaType, AudioVideoMedia.Failed)
+}
+
+func (v *Video) setImageMedia(clock timeutils.Clock, target **ImageMedia, media ImageMedia) error {
+	if err := media.IsValid(); err != nil {
+		return err
+	}
+	*target = &media
+	v.UpdatedAt = timeutils.Now(clock)
+	return nil
+}
+
+func (v *Video) setAudioVideoMedia(clock timeutils.Clock, target **AudioVideoMedia, media AudioVideoMedia) error {
+	if err := media.IsValid(); err != nil {
+		return err
+	}
+	*target = &media
+	v.UpdatedAt = timeutils.Now(clock)
+	return nil
+}
+
+func (v *Video) updateAudioVideoMedia(
+	clock timeutils.Clock,
+	mediaType MediaType,
+	transition func(AudioVideoMedia) (AudioVideoMedia, error),
+) error {
+	var target **AudioVideoMedia
+	switch mediaType {
+	case MediaTypeVideo:
+		target = &v.VideoMedia
+	case MediaTypeTrailer:
+		target = &v.Trailer
+	default:
+		return VideoError{fmt.Sprintf("'%s' is not an audio/video media", mediaType)}
+	}
+	if *target == nil {
+		return VideoError{fmt.Sprintf("video has no '%s' media", mediaType)}
+	}
+
+	media, err := transition(**target)
+	if err != nil {
+		return err
+	}
+	*target = &media
+	v.UpdatedAt = timeutils.Now(clock)
+	return nil
+}
+
+func addID[T any](clock timeutils.Clock, v *Video, ids []identifier.ID[T], id identifier.ID[T]) []identifier.ID[T] {
+	if id.IsZero() || slices.Contains(ids, id) {
+		return ids
+	}
+	v.UpdatedAt = timeutils.Now(clock)
+	return append(ids, id)
+}
+
+func removeID[T any](clock timeutils.Clock, v *Video, ids []identifier.ID[T], id identifier.ID[T]) []identifier.ID[T] {
+	index := slices.Index(ids, id)
+	if index < 0 {
+		return ids
+	}
+	v.UpdatedAt = timeutils.Now(clock)
+	return slices.Delete(ids, index, index+1)
+}
+
+func (v *Video) IsValid() error {
+	const (
+		maxTitleLength       = 255
+		maxDescriptionLength = 4000
+		firstLaunchYear      = 1888
+	)
+
+	notification := validation.NewNotification()
+
+	if v.ID.IsZero() {
+		notification.Append("id", validation.CodeRequired, "'id' should not be empty")
+	}
+
+	if strings.TrimSpace(v.Title) == "" {
+		notification.Append("title", validation.CodeRequired, "'title' should not be empty")
+	} else if len(v.Title) > maxTitleLength {
+		notification.Append("title", validation.CodeLength, fmt.Sprintf(
+			"'title' must be between 1 and %d characters",
+			maxTitleLength,
+		))
+	}
+
+	if len(v.Description) > maxDescriptionLength {
+		notification.Append("description", validation.CodeLength, fmt.Sprintf(
+			"'description' must be between 0 and %d characters",
+			maxDescriptionLength,
+		))
+	}
+
+	// The latest launch year accepted is the one after the video was last
+	// changed, so validating a stored video does not depend on today's date.
+	if v.LaunchYear == 0 {
+		notification.Append("launchYear", validation.CodeRequired, "'launchYear' should not be empty")
+	} else if lastLaunchYear := v.UpdatedAt.Year() + 1; v.LaunchYear < firstLaunchYear || v.LaunchYear > lastLaunchYear {
+		notification.Append("launchYear", validation.CodeRange, fmt.Sprintf(
+			"'launchYear' must be between %d and %d",
+			firstLaunchYear, lastLaunchYear,
+		))
+	}
+
+	if v.Duration <= 0 {
+		notification.Append("duration", validation.CodeRange, "'duration' must be greater than zero")
+	}
+
+	if v.Rating == "" {
+		notification.Append("rating", validation.CodeRequired, "'rating' should not be empty")
+	} else if !v.Rating.IsValid() {
+		notification.Append("rating", validation.CodeInvalid, invalidRatingMessage)
+	}
+	return notification.Err()
+}
+
+func uniqueIDs[T any](ids []identifier.ID[T]) []identifier.ID[T] {
+	result := make([]identifier.ID[T], 0, len(ids))
+	for _, id := range ids {
+		if id.IsZero() || slices.Contains(result, id) {
+			continue
+		}
+		result = append(result, id)
+	}
+	return result
+}
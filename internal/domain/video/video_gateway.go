@@ -0,0 +1,5 @@
+package video
+
+import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/gateway"
+
+type VideoGateway = gateway.Gateway[Video, VideoID]
@@ -0,0 +1,223 @@
+package video_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/genre"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/video"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+const (
+	validTitle       = "System Design no Mercado Livre na pratica"
+	validDescription = "Um video sobre arquitetura de sistemas"
+	validLaunchYear  = 2022
+	validDuration    = 120 * time.Minute
+)
+
+var (
+	categoryID1   = category.NewCategoryID()
+	categoryID2   = category.NewCategoryID()
+	genreID1      = genre.NewGenreID()
+	genreID2      = genre.NewGenreID()
+	castMemberID1 = castmember.NewCastMemberID()
+	castMemberID2 = castmember.NewCastMemberID()
+	castMemberID3 = castmember.NewCastMemberID()
+)
+
+func newValidVideo(t *testing.T) *video.Video {
+	t.Helper()
+	videoEntity, err := video.NewVideo(
+		nil,
+		validTitle,
+		validDescription,
+		validLaunchYear,
+		validDuration,
+		false,
+		false,
+		video.RatingL,
+		[]category.CategoryID{categoryID1},
+		[]genre.GenreID{genreID1},
+		[]castmember.CastMemberID{castMemberID1},
+	)
+	assert.NoError(t, err)
+	return videoEntity
+}
+
+func TestGivenAValidParams_WhenCallNewVideo_ThenInstantiateAVideo(t *testing.T) {
+	videoEntity, err := video.NewVideo(
+		nil,
+		validTitle,
+		validDescription,
+		validLaunchYear,
+		validDuration,
+		true,
+		true,
+		video.RatingAge16,
+		[]category.CategoryID{categoryID1, categoryID1, {}},
+		[]genre.GenreID{genreID1},
+		[]castmember.CastMemberID{castMemberID1, castMemberID2},
+	)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, videoEntity)
+	assert.NotEmpty(t, videoEntity.ID)
+	assert.Equal(t, validTitle, videoEntity.Title)
+	assert.Equal(t, validDescription, videoEntity.Description)
+	assert.Equal(t, validLaunchYear, videoEntity.LaunchYear)
+	assert.Equal(t, validDuration, videoEntity.Duration)
+	assert.True(t, videoEntity.Opened)
+	assert.True(t, videoEntity.Published)
+	assert.Equal(t, video.RatingAge16, videoEntity.Rating)
+	assert.Equal(t, []category.CategoryID{categoryID1}, videoEntity.CategoryIDs)
+	assert.Equal(t, []genre.GenreID{genreID1}, videoEntity.GenreIDs)
+	assert.Equal(t, []castmember.CastMemberID{castMemberID1, castMemberID2}, videoEntity.CastMemberIDs)
+	assert.NotZero(t, videoEntity.CreatedAt)
+	assert.Equal(t, videoEntity.CreatedAt, videoEntity.UpdatedAt)
+}
+
+func TestGivenManyInvalidParams_WhenCallNewVideo_ThenShouldReceiveEveryError(t *testing.T) {
+	_, err := video.NewVideo(
+		nil,
+		"",
+		strings.Repeat("a", 4001),
+		1500,
+		0,
+		false,
+		false,
+		video.Rating("PG-13"),
+		nil, nil, nil,
+	)
+
+	assert.Error(t, err)
+	var notification *validation.Notification
+	assert.True(t, errors.As(err, &notification))
+	assert.Equal(t, []validation.FieldError{
+		{Field: "title", Code: validation.CodeRequired, Message: "'title' should not be empty"},
+		{Field: "description", Code: validation.CodeLength, Message: "'description' must be between 0 and 4000 characters"},
+		{Field: "launchYear", Code: validation.CodeRange, Message: "'launchYear' must be between 1888 and " + yearAfterNow()},
+		{Field: "duration", Code: validation.CodeRange, Message: "'duration' must be greater than zero"},
+		{Field: "rating", Code: validation.CodeInvalid, Message: "'rating' must be one of 'ER', 'L', 'AGE_10', 'AGE_12', 'AGE_14', 'AGE_16' or 'AGE_18'"},
+	}, notification.Errors())
+}
+
+func TestGivenAnEmptyVideo_WhenCallIsValid_ThenShouldReportRequiredFields(t *testing.T) {
+	videoEntity := video.Video{}
+
+	err := videoEntity.IsValid()
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'id' should not be empty")
+	assert.Contains(t, err.Error(), "'title' should not be empty")
+	assert.Contains(t, err.Error(), "'launchYear' should not be empty")
+	assert.Contains(t, err.Error(), "'rating' should not be empty")
+}
+
+func TestGivenATitleLongerThan255_WhenCallNewVideo_ThenShouldReceiveAnError(t *testing.T) {
+	_, err := video.NewVideo(
+		nil,
+		strings.Repeat("a", 256),
+		validDescription,
+		validLaunchYear,
+		validDuration,
+		false,
+		false,
+		video.RatingL,
+		nil, nil, nil,
+	)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'title' must be between 1 and 255 characters")
+}
+
+func TestGivenAValidVideo_WhenCallUpdate_ThenReturnUpdatedVideo(t *testing.T) {
+	videoEntity := newValidVideo(t)
+	createdAt := videoEntity.CreatedAt
+
+	err := videoEntity.Update(
+		nil,
+		"Novo titulo",
+		"Nova descricao",
+		2020,
+		90*time.Minute,
+		true,
+		true,
+		video.RatingAge18,
+		[]category.CategoryID{categoryID2},
+		nil,
+		[]castmember.CastMemberID{castMemberID1, castMemberID3},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Novo titulo", videoEntity.Title)
+	assert.Equal(t, "Nova descricao", videoEntity.Description)
+	assert.Equal(t, 2020, videoEntity.LaunchYear)
+	assert.Equal(t, 90*time.Minute, videoEntity.Duration)
+	assert.True(t, videoEntity.Opened)
+	assert.True(t, videoEntity.Published)
+	assert.Equal(t, video.RatingAge18, videoEntity.Rating)
+	assert.Equal(t, []category.CategoryID{categoryID2}, videoEntity.CategoryIDs)
+	assert.Empty(t, videoEntity.GenreIDs)
+	assert.Equal(t, []castmember.CastMemberID{castMemberID1, castMemberID3}, videoEntity.CastMemberIDs)
+	assert.Equal(t, createdAt, videoEntity.CreatedAt)
+	assert.False(t, videoEntity.UpdatedAt.Before(createdAt))
+}
+
+func TestGivenAValidVideo_WhenCallUpdateWithInvalidParams_ThenShouldReceiveAnError(t *testing.T) {
+	videoEntity := newValidVideo(t)
+
+	err := videoEntity.Update(nil, "", "", validLaunchYear, -time.Second, false, false, video.RatingL, nil, nil, nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "'title' should not be empty")
+	assert.Contains(t, err.Error(), "'duration' must be greater than zero")
+}
+
+func TestGivenAValidVideo_WhenCallAddAndRemoveRelations_ThenShouldKeepSets(t *testing.T) {
+	videoEntity := newValidVideo(t)
+
+	videoEntity.AddCategory(nil, categoryID1)
+	videoEntity.AddCategory(nil, categoryID2)
+	videoEntity.RemoveCategory(nil, categoryID1)
+	videoEntity.AddGenre(nil, genreID2)
+	videoEntity.RemoveGenre(nil, genreID1)
+	videoEntity.AddCastMember(nil, castmember.CastMemberID{})
+	videoEntity.RemoveCastMember(nil, castmember.NewCastMemberID())
+
+	assert.Equal(t, []category.CategoryID{categoryID2}, videoEntity.CategoryIDs)
+	assert.Equal(t, []genre.GenreID{genreID2}, videoEntity.GenreIDs)
+	assert.Equal(t, []castmember.CastMemberID{castMemberID1}, videoEntity.CastMemberIDs)
+}
+
+func TestGivenARatingString_WhenCallParseRating_ThenShouldAcceptOnlyKnownRatings(t *testing.T) {
+	for _, expected := range video.Ratings() {
+		rating, err := video.ParseRating(string(expected))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, rating)
+	}
+
+	_, err := video.ParseRating("PG-13")
+	assert.Error(t, err)
+}
+
+func yearAfterNow() string {
+	return time.Now().AddDate(1, 0, 0).Format("2006")
+}
+
+func TestGivenAFakeClock_WhenCallNewVideo_ThenLaunchYearShouldBeCheckedAgainstIt(t *testing.T) {
+	clock := timeutils.NewFakeClock(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	videoEntity, err := video.NewVideo(clock, validTitle, "", 2031, validDuration, false, false, video.RatingL, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2030, videoEntity.CreatedAt.Year())
+
+	_, err = video.NewVideo(clock, validTitle, "", 2032, validDuration, false, false, video.RatingL, nil, nil, nil)
+	assert.ErrorContains(t, err, "'launchYear' must be between 1888 and 2031")
+}
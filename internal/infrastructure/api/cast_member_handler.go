@@ -0,0 +1,146 @@
+package api
+
+import (
+	"net/http"
+
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/presenter"
+)
+
+type castMemberRequest struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type CastMemberHandler struct {
+	create *castmemberusecase.CreateCastMemberUseCase
+	update *castmemberusecase.UpdateCastMemberUseCase
+	delete *castmemberusecase.DeleteCastMemberUseCase
+	get    *castmemberusecase.GetCastMemberByIDUseCase
+	list   *castmemberusecase.ListCastMembersUseCase
+	cursor *castmemberusecase.ListCastMembersByCursorUseCase
+}
+
+func NewCastMemberHandler(gateway castmember.CastMemberGateway, publisher event.Publisher, codec *pagination.CursorCodec) *CastMemberHandler {
+	return &CastMemberHandler{
+		create: castmemberusecase.NewCreateCastMemberUseCase(gateway, publisher),
+		update: castmemberusecase.NewUpdateCastMemberUseCase(gateway, publisher),
+		delete: castmemberusecase.NewDeleteCastMemberUseCase(gateway, publisher),
+		get:    castmemberusecase.NewGetCastMemberByIDUseCase(gateway),
+		list:   castmemberusecase.NewListCastMembersUseCase(gateway),
+		cursor: castmemberusecase.NewListCastMembersByCursorUseCase(gateway, codec),
+	}
+}
+
+func (h *CastMemberHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /cast_members", h.Create)
+	mux.HandleFunc("GET /cast_members", h.List)
+	mux.HandleFunc("GET /cast_members/{id}", h.GetByID)
+	mux.HandleFunc("PUT /cast_members/{id}", h.Update)
+	mux.HandleFunc("DELETE /cast_members/{id}", h.Delete)
+}
+
+func (h *CastMemberHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request castMemberRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeMessage(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	output, err := h.create.Execute(r.Context(), castmemberusecase.CreateCastMemberInput{
+		Name: request.Name,
+		Type: request.Type,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/cast_members/"+output.ID)
+	writeJSON(w, http.StatusCreated, presenter.NewCastMemberResponse(*output))
+}
+
+func (h *CastMemberHandler) List(w http.ResponseWriter, r *http.Request) {
+	if isCursorRequest(r) {
+		h.listByCursor(w, r)
+		return
+	}
+
+	query, err := parseSearchQuery(r)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if castMemberType := r.URL.Query().Get("type"); castMemberType != "" {
+		query.Filters = map[string]string{"type": castMemberType}
+	}
+
+	output, err := h.list.Execute(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pagination.MapItems(output, presenter.NewCastMemberResponse).WithLinks(pageLink(r.URL.Path, query, output.PerPage)))
+}
+
+func (h *CastMemberHandler) listByCursor(w http.ResponseWriter, r *http.Request) {
+	request, err := parseCursorRequest(r)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if castMemberType := r.URL.Query().Get("type"); castMemberType != "" {
+		request.Filters = map[string]string{"type": castMemberType}
+	}
+
+	output, err := h.cursor.Execute(r.Context(), request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pagination.MapTokenItems(output, presenter.NewCastMemberResponse))
+}
+
+func (h *CastMemberHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	output, err := h.get.Execute(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, presenter.NewCastMemberResponse(*output))
+}
+
+func (h *CastMemberHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var request castMemberRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeMessage(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	output, err := h.update.Execute(r.Context(), castmemberusecase.UpdateCastMemberInput{
+		ID:   r.PathValue("id"),
+		Name: request.Name,
+		Type: request.Type,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, presenter.NewCastMemberResponse(*output))
+}
+
+func (h *CastMemberHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.delete.Execute(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
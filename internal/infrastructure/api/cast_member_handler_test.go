@@ -0,0 +1,156 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/api"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+type castMemberBody struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func newCastMemberServer() (http.Handler, *memory.CastMemberGateway) {
+	gateway := memory.NewCastMemberGateway()
+	return api.NewRouter(api.NewCastMemberHandler(gateway, eventtest.NewRecorder(), cursorCodec)), gateway
+}
+
+func createCastMember(t *testing.T, handler http.Handler, name, castMemberType string) castMemberBody {
+	t.Helper()
+	recorder := doRequest(t, handler, http.MethodPost, "/cast_members", `{"name":"`+name+`","type":"`+castMemberType+`"}`)
+	require.Equal(t, http.StatusCreated, recorder.Code, recorder.Body.String())
+	return decodeBody[castMemberBody](t, recorder)
+}
+
+func TestGivenAValidBody_WhenPostCastMembers_ThenShouldReturn201(t *testing.T) {
+	handler, gateway := newCastMemberServer()
+
+	recorder := doRequest(t, handler, http.MethodPost, "/cast_members", `{"name":"Vin Diesel","type":"actor"}`)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	body := decodeBody[castMemberBody](t, recorder)
+	assert.Equal(t, "/cast_members/"+body.ID, recorder.Header().Get("Location"))
+	assert.Equal(t, "Vin Diesel", body.Name)
+	assert.Equal(t, "ACTOR", body.Type)
+	assert.NotEmpty(t, body.CreatedAt)
+
+	id, err := castmember.ParseCastMemberID(body.ID)
+	require.NoError(t, err)
+	_, err = gateway.FindByID(t.Context(), id)
+	assert.NoError(t, err)
+}
+
+func TestGivenAnInvalidBody_WhenPostCastMembers_ThenShouldReturn422ListingEveryField(t *testing.T) {
+	handler, _ := newCastMemberServer()
+
+	recorder := doRequest(t, handler, http.MethodPost, "/cast_members", `{"name":"","type":"PRODUCER"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	body := decodeBody[errorBody](t, recorder)
+	assert.Equal(t, "one or more fields are invalid", body.Message)
+	require.Len(t, body.Errors, 2)
+	assert.Equal(t, "name", body.Errors[0].Field)
+	assert.Equal(t, "required", body.Errors[0].Code)
+	assert.Equal(t, "type", body.Errors[1].Field)
+	assert.Equal(t, "invalid", body.Errors[1].Code)
+	assert.Equal(t, "'type' must be either 'ACTOR' or 'DIRECTOR'", body.Errors[1].Message)
+}
+
+func TestGivenAnExistingCastMember_WhenGetCastMemberByID_ThenShouldReturn200(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	created := createCastMember(t, handler, "Vin Diesel", "ACTOR")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/cast_members/"+created.ID, "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, created, decodeBody[castMemberBody](t, recorder))
+}
+
+func TestGivenAnUnknownID_WhenGetCastMemberByID_ThenShouldReturn404(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	id := castmember.NewCastMemberID().String()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/cast_members/"+id, "")
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "cast member with id '"+id+"' was not found", decodeBody[errorBody](t, recorder).Message)
+}
+
+func TestGivenAnExistingCastMember_WhenPutCastMember_ThenShouldReturn200(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	created := createCastMember(t, handler, "Vin Diesel", "ACTOR")
+
+	recorder := doRequest(t, handler, http.MethodPut, "/cast_members/"+created.ID, `{"name":"Greta Gerwig","type":"DIRECTOR"}`)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[castMemberBody](t, recorder)
+	assert.Equal(t, created.ID, body.ID)
+	assert.Equal(t, "Greta Gerwig", body.Name)
+	assert.Equal(t, "DIRECTOR", body.Type)
+
+	recorder = doRequest(t, handler, http.MethodPut, "/cast_members/"+created.ID, `{"name":"Greta Gerwig","type":""}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func TestGivenAnExistingCastMember_WhenDeleteCastMember_ThenShouldReturn204AndThen404(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	created := createCastMember(t, handler, "Vin Diesel", "ACTOR")
+
+	recorder := doRequest(t, handler, http.MethodDelete, "/cast_members/"+created.ID, "")
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+
+	recorder = doRequest(t, handler, http.MethodDelete, "/cast_members/"+created.ID, "")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGivenCastMembers_WhenGetCastMembersWithTypeFilter_ThenShouldReturnOnlyThatType(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	createCastMember(t, handler, "Vin Diesel", "ACTOR")
+	createCastMember(t, handler, "Martin Scorsese", "DIRECTOR")
+	createCastMember(t, handler, "Kevin Costner", "DIRECTOR")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/cast_members?search=in&type=director", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[pageBody[castMemberBody]](t, recorder)
+	assert.Equal(t, int64(2), body.Total)
+	require.Len(t, body.Items, 2)
+	assert.Equal(t, "Kevin Costner", body.Items[0].Name)
+	assert.Equal(t, "Martin Scorsese", body.Items[1].Name)
+}
+
+func TestGivenAnInvalidTypeFilter_WhenGetCastMembers_ThenShouldReturn422(t *testing.T) {
+	handler, _ := newCastMemberServer()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/cast_members?type=PRODUCER", "")
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	body := decodeBody[errorBody](t, recorder)
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, "type", body.Errors[0].Field)
+}
+
+func TestGivenCastMembers_WhenGetCastMembersWithSizeAndTypeFilter_ThenShouldReturnATokenPage(t *testing.T) {
+	handler, _ := newCastMemberServer()
+	createCastMember(t, handler, "Vin Diesel", "ACTOR")
+	createCastMember(t, handler, "Martin Scorsese", "DIRECTOR")
+	createCastMember(t, handler, "Kevin Costner", "DIRECTOR")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/cast_members?size=1&type=director", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[tokenPageBody[castMemberBody]](t, recorder)
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "Kevin Costner", body.Items[0].Name)
+	assert.NotEmpty(t, body.Next)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"net/http"
+
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/presenter"
+)
+
+type categoryRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsActive    *bool  `json:"is_active"`
+}
+
+func (r categoryRequest) isActive() bool {
+	return r.IsActive == nil || *r.IsActive
+}
+
+type CategoryHandler struct {
+	create *categoryusecase.CreateCategoryUseCase
+	update *categoryusecase.UpdateCategoryUseCase
+	delete *categoryusecase.DeleteCategoryUseCase
+	get    *categoryusecase.GetCategoryByIDUseCase
+	list   *categoryusecase.ListCategoriesUseCase
+	cursor *categoryusecase.ListCategoriesByCursorUseCase
+}
+
+func NewCategoryHandler(gateway category.CategoryGateway, publisher event.Publisher, codec *pagination.CursorCodec) *CategoryHandler {
+	return &CategoryHandler{
+		create: categoryusecase.NewCreateCategoryUseCase(gateway, publisher),
+		update: categoryusecase.NewUpdateCategoryUseCase(gateway, publisher),
+		delete: categoryusecase.NewDeleteCategoryUseCase(gateway, publisher),
+		get:    categoryusecase.NewGetCategoryByIDUseCase(gateway),
+		list:   categoryusecase.NewListCategoriesUseCase(gateway),
+		cursor: categoryusecase.NewListCategoriesByCursorUseCase(gateway, codec),
+	}
+}
+
+func (h *CategoryHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("POST /categories", h.Create)
+	mux.HandleFunc("GET /categories", h.List)
+	mux.HandleFunc("GET /categories/{id}", h.GetByID)
+	mux.HandleFunc("PUT /categories/{id}", h.Update)
+	mux.HandleFunc("DELETE /categories/{id}", h.Delete)
+}
+
+func (h *CategoryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var request categoryRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeMessage(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	output, err := h.create.Execute(r.Context(), categoryusecase.CreateCategoryInput{
+		Name:        request.Name,
+		Description: request.Description,
+		IsActive:    request.isActive(),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/categories/"+output.ID)
+	writeJSON(w, http.StatusCreated, presenter.NewCategoryResponse(*output))
+}
+
+func (h *CategoryHandler) List(w http.ResponseWriter, r *http.Request) {
+	if isCursorRequest(r) {
+		h.listByCursor(w, r)
+		return
+	}
+
+	query, err := parseSearchQuery(r)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.list.Execute(r.Context(), query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pagination.MapItems(output, presenter.NewCategoryResponse).WithLinks(pageLink(r.URL.Path, query, output.PerPage)))
+}
+
+func (h *CategoryHandler) listByCursor(w http.ResponseWriter, r *http.Request) {
+	request, err := parseCursorRequest(r)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.cursor.Execute(r.Context(), request)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pagination.MapTokenItems(output, presenter.NewCategoryResponse))
+}
+
+func (h *CategoryHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	output, err := h.get.Execute(r.Context(), r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, presenter.NewCategoryResponse(*output))
+}
+
+func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var request categoryRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeMessage(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	output, err := h.update.Execute(r.Context(), categoryusecase.UpdateCategoryInput{
+		ID:          r.PathValue("id"),
+		Name:        request.Name,
+		Description: request.Description,
+		IsActive:    request.isActive(),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, presenter.NewCategoryResponse(*output))
+}
+
+func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.delete.Execute(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,335 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/api"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+type categoryBody struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	IsActive    bool    `json:"is_active"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   *string `json:"deleted_at"`
+}
+
+type errorBody struct {
+	Message string `json:"message"`
+	Errors  []struct {
+		Field   string `json:"field"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type pageBody[T any] struct {
+	CurrentPage int   `json:"current_page"`
+	PerPage     int   `json:"per_page"`
+	Total       int64 `json:"total"`
+	TotalPages  int64 `json:"total_pages"`
+	HasNext     bool  `json:"has_next"`
+	HasPrevious bool  `json:"has_previous"`
+	IsLast      bool  `json:"is_last"`
+	Links       struct {
+		Self  string `json:"self"`
+		First string `json:"first"`
+		Prev  string `json:"prev"`
+		Next  string `json:"next"`
+		Last  string `json:"last"`
+	} `json:"links"`
+	Items []T `json:"items"`
+}
+
+type tokenPageBody[T any] struct {
+	Size  int    `json:"size"`
+	Items []T    `json:"items"`
+	Next  string `json:"next"`
+	Prev  string `json:"prev"`
+}
+
+var cursorCodec = pagination.NewCursorCodec([]byte("secret"))
+
+func newCategoryServer() (http.Handler, *memory.CategoryGateway) {
+	gateway := memory.NewCategoryGateway()
+	return api.NewRouter(api.NewCategoryHandler(gateway, eventtest.NewRecorder(), cursorCodec)), gateway
+}
+
+func doRequest(t *testing.T, handler http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	request := httptest.NewRequest(method, target, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func decodeBody[T any](t *testing.T, recorder *httptest.ResponseRecorder) T {
+	t.Helper()
+	var body T
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body), recorder.Body.String())
+	return body
+}
+
+func createCategory(t *testing.T, handler http.Handler, name string) categoryBody {
+	t.Helper()
+	recorder := doRequest(t, handler, http.MethodPost, "/categories", `{"name":"`+name+`","description":"desc"}`)
+	require.Equal(t, http.StatusCreated, recorder.Code, recorder.Body.String())
+	return decodeBody[categoryBody](t, recorder)
+}
+
+func TestGivenAValidBody_WhenPostCategories_ThenShouldReturn201(t *testing.T) {
+	handler, gateway := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodPost, "/categories",
+		`{"name":"Filmes","description":"A categoria mais assistida","is_active":true}`)
+
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	body := decodeBody[categoryBody](t, recorder)
+	assert.Equal(t, "/categories/"+body.ID, recorder.Header().Get("Location"))
+	assert.Equal(t, "Filmes", body.Name)
+	assert.Equal(t, "A categoria mais assistida", body.Description)
+	assert.True(t, body.IsActive)
+	assert.Regexp(t, `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}Z$`, body.CreatedAt)
+	assert.Nil(t, body.DeletedAt)
+
+	id, err := category.ParseCategoryID(body.ID)
+	require.NoError(t, err)
+	_, err = gateway.FindByID(t.Context(), id)
+	assert.NoError(t, err)
+}
+
+func TestGivenAnInvalidName_WhenPostCategories_ThenShouldReturn422WithFieldErrors(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodPost, "/categories", `{"name":"ab"}`)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	body := decodeBody[errorBody](t, recorder)
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, "name", body.Errors[0].Field)
+	assert.Equal(t, "length", body.Errors[0].Code)
+	assert.Equal(t, "'name' must be between 3 and 255 characters", body.Errors[0].Message)
+}
+
+func TestGivenAMalformedBody_WhenPostCategories_ThenShouldReturn400(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodPost, "/categories", `{"name":`)
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestGivenAnExistingCategory_WhenGetCategoryByID_ThenShouldReturn200(t *testing.T) {
+	handler, _ := newCategoryServer()
+	created := createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories/"+created.ID, "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, created, decodeBody[categoryBody](t, recorder))
+}
+
+func TestGivenAnUnknownID_WhenGetCategoryByID_ThenShouldReturn404(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	for _, id := range []string{category.NewCategoryID().String(), "123"} {
+		recorder := doRequest(t, handler, http.MethodGet, "/categories/"+id, "")
+
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Equal(t, "category with id '"+id+"' was not found", decodeBody[errorBody](t, recorder).Message)
+	}
+}
+
+func TestGivenAnExistingCategory_WhenPutCategory_ThenShouldReturn200(t *testing.T) {
+	handler, _ := newCategoryServer()
+	created := createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodPut, "/categories/"+created.ID,
+		`{"name":"Series","description":"Atualizada","is_active":false}`)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[categoryBody](t, recorder)
+	assert.Equal(t, created.ID, body.ID)
+	assert.Equal(t, "Series", body.Name)
+	assert.False(t, body.IsActive)
+	assert.NotNil(t, body.DeletedAt)
+}
+
+func TestGivenInvalidInput_WhenPutCategory_ThenShouldReturn404Or422(t *testing.T) {
+	handler, _ := newCategoryServer()
+	created := createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodPut, "/categories/"+category.NewCategoryID().String(), `{"name":"Series"}`)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = doRequest(t, handler, http.MethodPut, "/categories/"+created.ID, `{"name":""}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func TestGivenAnExistingCategory_WhenDeleteCategory_ThenShouldReturn204AndThen404(t *testing.T) {
+	handler, _ := newCategoryServer()
+	created := createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodDelete, "/categories/"+created.ID, "")
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+
+	recorder = doRequest(t, handler, http.MethodDelete, "/categories/"+created.ID, "")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
+
+func TestGivenCategories_WhenGetCategoriesWithQueryParams_ThenShouldReturnAPage(t *testing.T) {
+	handler, _ := newCategoryServer()
+	for _, name := range []string{"Filmes", "Series", "Documentarios", "Filmes de Acao"} {
+		createCategory(t, handler, name)
+	}
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?search=filmes&page=0&perPage=1&sort=name&dir=desc", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[pageBody[categoryBody]](t, recorder)
+	assert.Equal(t, 0, body.CurrentPage)
+	assert.Equal(t, 1, body.PerPage)
+	assert.Equal(t, int64(2), body.Total)
+	require.Len(t, body.Items, 1)
+	assert.Equal(t, "Filmes de Acao", body.Items[0].Name)
+	assert.Equal(t, int64(2), body.TotalPages)
+	assert.True(t, body.HasNext)
+	assert.False(t, body.HasPrevious)
+	assert.False(t, body.IsLast)
+	assert.Equal(t, "/categories?dir=desc&page=0&perPage=1&search=filmes&sort=name", body.Links.Self)
+	assert.Equal(t, "/categories?dir=desc&page=1&perPage=1&search=filmes&sort=name", body.Links.Next)
+	assert.Equal(t, body.Links.Next, body.Links.Last)
+	assert.Empty(t, body.Links.Prev)
+}
+
+func TestGivenNoQueryParams_WhenGetCategories_ThenShouldUseDefaults(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[pageBody[categoryBody]](t, recorder)
+	assert.Equal(t, 10, body.PerPage)
+	assert.NotNil(t, body.Items)
+	assert.Empty(t, body.Items)
+}
+
+func TestGivenInvalidQueryParams_WhenGetCategories_ThenShouldReturnAnError(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?page=abc", "")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder = doRequest(t, handler, http.MethodGet, "/categories?sort=password", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, "sort", decodeBody[errorBody](t, recorder).Errors[0].Field)
+
+	recorder = doRequest(t, handler, http.MethodGet, "/categories?page=922337203685477581", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, "page", decodeBody[errorBody](t, recorder).Errors[0].Field)
+}
+
+func TestGivenAnOversizedPerPage_WhenGetCategories_ThenShouldClampItAndLinkTheClampedSize(t *testing.T) {
+	handler, _ := newCategoryServer()
+	createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?perPage=1000", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[pageBody[categoryBody]](t, recorder)
+	assert.Equal(t, 100, body.PerPage)
+	assert.Equal(t, "/categories?page=0&perPage=100", body.Links.Self)
+}
+
+func TestGivenAnInvalidDirection_WhenGetCategories_ThenShouldReturnUnprocessableEntity(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?dir=sideways", "")
+
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, "direction", decodeBody[errorBody](t, recorder).Errors[0].Field)
+}
+
+func TestGivenCategories_WhenGetCategoriesWithCursorTokens_ThenShouldWalkEveryPage(t *testing.T) {
+	handler, _ := newCategoryServer()
+	for _, name := range []string{"Series", "Anime", "Filmes"} {
+		createCategory(t, handler, name)
+	}
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?size=2", "")
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	first := decodeBody[tokenPageBody[categoryBody]](t, recorder)
+	assert.Equal(t, 2, first.Size)
+	assert.Equal(t, []string{"Anime", "Filmes"}, []string{first.Items[0].Name, first.Items[1].Name})
+	assert.Empty(t, first.Prev)
+	require.NotEmpty(t, first.Next)
+
+	recorder = doRequest(t, handler, http.MethodGet, "/categories?size=2&after="+url.QueryEscape(first.Next), "")
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	second := decodeBody[tokenPageBody[categoryBody]](t, recorder)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "Series", second.Items[0].Name)
+	assert.Empty(t, second.Next)
+	assert.NotEmpty(t, second.Prev)
+}
+
+func TestGivenInvalidCursorParams_WhenGetCategories_ThenShouldReturnAnError(t *testing.T) {
+	handler, _ := newCategoryServer()
+
+	assert.Equal(t, http.StatusBadRequest, doRequest(t, handler, http.MethodGet, "/categories?size=abc", "").Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(t, handler, http.MethodGet, "/categories?size=2&page=1", "").Code)
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories?after=forged", "")
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	body := decodeBody[errorBody](t, recorder)
+	require.Len(t, body.Errors, 1)
+	assert.Equal(t, "cursor", body.Errors[0].Field)
+}
+
+// stalledCategoryGateway never answers a listing until its caller gives up.
+type stalledCategoryGateway struct {
+	category.CategoryGateway
+}
+
+func (stalledCategoryGateway) FindAll(ctx context.Context, _ pagination.SearchQuery) (*pagination.Pagination[category.Category], error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestGivenAStalledGateway_WhenTheRequestTimesOut_ThenShouldReturnGatewayTimeout(t *testing.T) {
+	handler := api.WithTimeout(api.NewRouter(api.NewCategoryHandler(stalledCategoryGateway{}, eventtest.NewRecorder(), cursorCodec)), 20*time.Millisecond)
+
+	recorder := doRequest(t, handler, http.MethodGet, "/categories", "")
+
+	assert.Equal(t, http.StatusGatewayTimeout, recorder.Code)
+}
+
+func TestGivenAStalledGateway_WhenTheClientGoesAway_ThenShouldAbandonTheRequest(t *testing.T) {
+	handler := api.NewRouter(api.NewCategoryHandler(stalledCategoryGateway{}, eventtest.NewRecorder(), cursorCodec))
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/categories", nil)
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+
+	assert.Equal(t, 499, recorder.Code)
+}
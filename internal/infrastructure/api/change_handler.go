@@ -0,0 +1,88 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+)
+
+const (
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+)
+
+type changeFeedResponse struct {
+	Changes []cdc.Change `json:"changes"`
+	// Next is the offset to pass as since to read on from this page.
+	Next uint64 `json:"next"`
+}
+
+// changesGoneResponse tells a reader that has to resync where to resume
+// from: list the resources in full, then read on from Next.
+type changesGoneResponse struct {
+	Message string `json:"message"`
+	Next    uint64 `json:"next"`
+}
+
+type ChangeHandler struct {
+	changes cdc.Feed
+}
+
+func NewChangeHandler(changes cdc.Feed) *ChangeHandler {
+	return &ChangeHandler{changes: changes}
+}
+
+func (h *ChangeHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /changes", h.List)
+}
+
+// List answers the changes after the since offset, at most limit of them.
+// A since the log no longer retains, or has not reached, answers 410 Gone
+// with the offset of the latest change as next.
+func (h *ChangeHandler) List(w http.ResponseWriter, r *http.Request) {
+	since, limit, err := parseChangesQuery(r)
+	if err != nil {
+		writeMessage(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	changes, last, err := h.changes.Since(r.Context(), since, limit)
+	if errors.Is(err, cdc.ErrOffsetExpired) || errors.Is(err, cdc.ErrOffsetAhead) {
+		writeJSON(w, http.StatusGone, changesGoneResponse{Message: err.Error(), Next: last})
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	next := since
+	if len(changes) > 0 {
+		next = changes[len(changes)-1].Offset
+	}
+	writeJSON(w, http.StatusOK, changeFeedResponse{Changes: changes, Next: next})
+}
+
+func parseChangesQuery(r *http.Request) (uint64, int, error) {
+	params := r.URL.Query()
+
+	var since uint64
+	if value := params.Get("since"); value != "" {
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("'since' must be a non-negative integer: %w", err)
+		}
+		since = parsed
+	}
+	limit, err := intParam(params.Get("limit"))
+	if err != nil {
+		return 0, 0, fmt.Errorf("'limit' must be an integer: %w", err)
+	}
+	if limit <= 0 {
+		limit = defaultChangesLimit
+	}
+	return since, min(limit, maxChangesLimit), nil
+}
@@ -0,0 +1,95 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/api"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+type changeFeedBody struct {
+	Changes []cdc.Change `json:"changes"`
+	Next    uint64       `json:"next"`
+}
+
+func newChangeServer(options ...cdc.LogOption) http.Handler {
+	changes := cdc.NewLog(options...)
+	gateway := cdc.NewCategoryGateway(memory.NewCategoryGateway(), changes)
+	return api.NewRouter(
+		api.NewCategoryHandler(gateway, eventtest.NewRecorder(), cursorCodec),
+		api.NewChangeHandler(changes),
+	)
+}
+
+func TestGivenCategoryWrites_WhenGetChanges_ThenShouldReturnTheirEnvelopes(t *testing.T) {
+	handler := newChangeServer()
+	created := createCategory(t, handler, "Filmes")
+	require.Equal(t, http.StatusNoContent, doRequest(t, handler, http.MethodDelete, "/categories/"+created.ID, "").Code)
+
+	recorder := doRequest(t, handler, http.MethodGet, "/changes", "")
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	body := decodeBody[changeFeedBody](t, recorder)
+	require.Len(t, body.Changes, 2)
+	assert.Equal(t, uint64(2), body.Next)
+	assert.Equal(t, cdc.OpCreate, body.Changes[0].Payload.Op)
+	assert.Equal(t, cdc.OpDelete, body.Changes[1].Payload.Op)
+	assert.Equal(t, "categories", body.Changes[1].Payload.Source.Table)
+	assert.JSONEq(t, "null", string(body.Changes[1].Payload.After))
+}
+
+func TestGivenASinceAndLimit_WhenGetChanges_ThenShouldReturnThePageAfterIt(t *testing.T) {
+	handler := newChangeServer()
+	for _, name := range []string{"Filmes", "Séries", "Documentários"} {
+		createCategory(t, handler, name)
+	}
+
+	body := decodeBody[changeFeedBody](t, doRequest(t, handler, http.MethodGet, "/changes?since=1&limit=1", ""))
+	require.Len(t, body.Changes, 1)
+	assert.Equal(t, uint64(2), body.Changes[0].Offset)
+	assert.Equal(t, uint64(2), body.Next)
+
+	body = decodeBody[changeFeedBody](t, doRequest(t, handler, http.MethodGet, "/changes?since=3", ""))
+	assert.Empty(t, body.Changes)
+	assert.Equal(t, uint64(3), body.Next)
+}
+
+func TestGivenAnExpiredOffset_WhenGetChanges_ThenShouldReturn410(t *testing.T) {
+	handler := newChangeServer(cdc.WithRetention(1))
+	createCategory(t, handler, "Filmes")
+	createCategory(t, handler, "Séries")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/changes?since=0", "")
+
+	assert.Equal(t, http.StatusGone, recorder.Code)
+	gone := decodeBody[changeFeedBody](t, recorder)
+	assert.Equal(t, uint64(2), gone.Next)
+
+	recorder = doRequest(t, handler, http.MethodGet, "/changes?since=2", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, decodeBody[changeFeedBody](t, recorder).Changes)
+}
+
+func TestGivenAnOffsetPastTheLatestChange_WhenGetChanges_ThenShouldReturn410(t *testing.T) {
+	handler := newChangeServer()
+	createCategory(t, handler, "Filmes")
+
+	recorder := doRequest(t, handler, http.MethodGet, "/changes?since=5", "")
+
+	assert.Equal(t, http.StatusGone, recorder.Code)
+	assert.Equal(t, uint64(1), decodeBody[changeFeedBody](t, recorder).Next)
+}
+
+func TestGivenAnInvalidSince_WhenGetChanges_ThenShouldReturn400(t *testing.T) {
+	handler := newChangeServer()
+
+	recorder := doRequest(t, handler, http.MethodGet, "/changes?since=-1", "")
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, decodeBody[errorBody](t, recorder).Message, "'since' must be a non-negative integer")
+}
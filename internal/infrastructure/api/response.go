@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	apperror "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/app-error"
+)
+
+// statusClientClosedRequest is the de facto status for a request the client
+// abandoned before it was answered; net/http has no constant for it.
+const statusClientClosedRequest = 499
+
+type fieldErrorResponse struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type errorResponse struct {
+	Message string               `json:"message"`
+	Errors  []fieldErrorResponse `json:"errors,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Printf("api: failed to encode response: %v", err)
+	}
+}
+
+func writeMessage(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Message: message})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var (
+		notFoundErr   apperror.NotFoundError
+		validationErr apperror.ValidationError
+		conflictErr   apperror.ConflictError
+	)
+	switch {
+	case errors.As(err, &notFoundErr):
+		writeMessage(w, http.StatusNotFound, notFoundErr.Error())
+	case errors.As(err, &validationErr):
+		response := errorResponse{
+			Message: "one or more fields are invalid",
+			Errors:  make([]fieldErrorResponse, len(validationErr.Errors)),
+		}
+		for i, fieldErr := range validationErr.Errors {
+			response.Errors[i] = fieldErrorResponse{
+				Field:   fieldErr.Field,
+				Code:    fieldErr.Code,
+				Message: fieldErr.Message,
+			}
+		}
+		writeJSON(w, http.StatusUnprocessableEntity, response)
+	case errors.As(err, &conflictErr):
+		writeMessage(w, http.StatusConflict, conflictErr.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		writeMessage(w, http.StatusGatewayTimeout, "the request took too long to complete")
+	case errors.Is(err, context.Canceled):
+		writeMessage(w, statusClientClosedRequest, "the request was canceled")
+	default:
+		log.Printf("api: unexpected error: %v", err)
+		writeMessage(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func decodeJSON(r *http.Request, target any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type Registrar interface {
+	Register(mux *http.ServeMux)
+}
+
+func NewRouter(registrars ...Registrar) http.Handler {
+	mux := http.NewServeMux()
+	for _, registrar := range registrars {
+		registrar.Register(mux)
+	}
+	return mux
+}
+
+// WithTimeout bounds every request to timeout. The deadline travels with the
+// request context down to the gateways, which abandon their work once it
+// passes; the handler then answers 504 Gateway Timeout.
+func WithTimeout(next http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
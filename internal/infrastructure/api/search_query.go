@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// parseSearchQuery only decodes the request; defaults, limits and the allowed
+// sorts are applied by the list use cases.
+func parseSearchQuery(r *http.Request) (pagination.SearchQuery, error) {
+	params := r.URL.Query()
+
+	page, err := intParam(params.Get("page"))
+	if err != nil {
+		return pagination.SearchQuery{}, fmt.Errorf("'page' must be an integer: %w", err)
+	}
+	perPage, err := intParam(params.Get("perPage"))
+	if err != nil {
+		return pagination.SearchQuery{}, fmt.Errorf("'perPage' must be an integer: %w", err)
+	}
+
+	return pagination.SearchQuery{
+		Page:      page,
+		PerPage:   perPage,
+		Terms:     params.Get("search"),
+		Sort:      params.Get("sort"),
+		Direction: params.Get("dir"),
+	}, nil
+}
+
+// isCursorRequest reports whether a listing asks for cursor paging, which
+// any of size, after or before selects instead of page and perPage.
+func isCursorRequest(r *http.Request) bool {
+	params := r.URL.Query()
+	return params.Has("size") || params.Has("after") || params.Has("before")
+}
+
+func parseCursorRequest(r *http.Request) (pagination.CursorRequest, error) {
+	params := r.URL.Query()
+	if params.Has("page") || params.Has("perPage") {
+		return pagination.CursorRequest{}, errors.New("'page' and 'perPage' cannot be combined with 'size', 'after' or 'before'")
+	}
+
+	size, err := intParam(params.Get("size"))
+	if err != nil {
+		return pagination.CursorRequest{}, fmt.Errorf("'size' must be an integer: %w", err)
+	}
+
+	return pagination.CursorRequest{
+		Size:      size,
+		Terms:     params.Get("search"),
+		Sort:      params.Get("sort"),
+		Direction: params.Get("dir"),
+		After:     params.Get("after"),
+		Before:    params.Get("before"),
+	}, nil
+}
+
+// pageLink renders the address of another page of the same search, so list
+// responses can carry navigation links. perPage is the size the listing
+// actually used, which may differ from the requested one once clamped.
+func pageLink(path string, query pagination.SearchQuery, perPage int) func(page int) string {
+	return func(page int) string {
+		params := url.Values{}
+		if query.Terms != "" {
+			params.Set("search", query.Terms)
+		}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("perPage", strconv.Itoa(perPage))
+		if query.Sort != "" {
+			params.Set("sort", query.Sort)
+		}
+		if query.Direction != "" {
+			params.Set("dir", query.Direction)
+		}
+		for key, value := range query.Filters {
+			params.Set(key, value)
+		}
+		return path + "?" + params.Encode()
+	}
+}
+
+func intParam(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
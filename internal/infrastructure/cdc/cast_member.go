@@ -0,0 +1,43 @@
+package cdc
+
+import (
+	"time"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+)
+
+// CastMemberRow is the image of a cast member in the change stream, with
+// times in microseconds since the Unix epoch.
+type CastMemberRow struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+func NewCastMemberRow(c *castmember.CastMember) CastMemberRow {
+	return CastMemberRow{
+		ID:        c.ID.String(),
+		Name:      c.Name,
+		Type:      string(c.Type),
+		CreatedAt: c.CreatedAt.UnixMicro(),
+		UpdatedAt: c.UpdatedAt.UnixMicro(),
+	}
+}
+
+type CastMemberGateway = Gateway[castmember.CastMember, castmember.CastMemberID]
+
+var _ castmember.CastMemberGateway = (*CastMemberGateway)(nil)
+
+var CastMemberTable = Table[castmember.CastMember, castmember.CastMemberID]{
+	Name:        "cast_members",
+	Key:         func(c *castmember.CastMember) castmember.CastMemberID { return c.ID },
+	Row:         func(c *castmember.CastMember) any { return NewCastMemberRow(c) },
+	ChangedAt:   func(c *castmember.CastMember) time.Time { return c.UpdatedAt },
+	ErrNotFound: castmember.ErrCastMemberNotFound,
+}
+
+func NewCastMemberGateway(inner castmember.CastMemberGateway, log *Log) *CastMemberGateway {
+	return NewGateway(inner, log, CastMemberTable)
+}
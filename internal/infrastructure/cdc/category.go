@@ -0,0 +1,51 @@
+package cdc
+
+import (
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+)
+
+// CategoryRow is the image of a category in the change stream. Times are
+// microseconds since the Unix epoch, as Debezium encodes timestamps.
+type CategoryRow struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"is_active"`
+	CreatedAt   int64  `json:"created_at"`
+	UpdatedAt   int64  `json:"updated_at"`
+	DeletedAt   *int64 `json:"deleted_at"`
+}
+
+func NewCategoryRow(c *category.Category) CategoryRow {
+	row := CategoryRow{
+		ID:          c.ID.String(),
+		Name:        c.Name,
+		Description: c.Description,
+		IsActive:    c.Active,
+		CreatedAt:   c.CreatedAt.UnixMicro(),
+		UpdatedAt:   c.UpdatedAt.UnixMicro(),
+	}
+	if c.DeletedAt != nil {
+		deletedAt := c.DeletedAt.UnixMicro()
+		row.DeletedAt = &deletedAt
+	}
+	return row
+}
+
+type CategoryGateway = Gateway[category.Category, category.CategoryID]
+
+var _ category.CategoryGateway = (*CategoryGateway)(nil)
+
+var CategoryTable = Table[category.Category, category.CategoryID]{
+	Name:        "categories",
+	Key:         func(c *category.Category) category.CategoryID { return c.ID },
+	Row:         func(c *category.Category) any { return NewCategoryRow(c) },
+	ChangedAt:   func(c *category.Category) time.Time { return c.UpdatedAt },
+	ErrNotFound: category.ErrCategoryNotFound,
+}
+
+func NewCategoryGateway(inner category.CategoryGateway, log *Log) *CategoryGateway {
+	return NewGateway(inner, log, CategoryTable)
+}
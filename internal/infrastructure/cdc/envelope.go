@@ -0,0 +1,43 @@
+package cdc
+
+import "encoding/json"
+
+const (
+	connectorName = "admin-catalogo-video-go"
+	sourceName    = "catalog"
+)
+
+// Op tells what happened to the row, using the Debezium operation codes.
+type Op string
+
+const (
+	OpCreate Op = "c"
+	OpUpdate Op = "u"
+	OpDelete Op = "d"
+)
+
+// Source says where a change comes from. TsMs is when the change was made,
+// taken from the row itself where it carries that time.
+type Source struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	Table     string `json:"table"`
+	TsMs      int64  `json:"ts_ms"`
+}
+
+// Envelope describes one change to one row in the Debezium layout: the row
+// before and after it, either of which is null when the row did not exist,
+// and TsMs, when the change was captured.
+type Envelope struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+	Source Source          `json:"source"`
+	Op     Op              `json:"op"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+// Change is an Envelope at its place in the Log.
+type Change struct {
+	Offset  uint64   `json:"offset"`
+	Payload Envelope `json:"payload"`
+}
@@ -0,0 +1,42 @@
+package cdc
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const followBatchSize = 100
+
+// Follow copies the changes of feed after offset to sink, in order, polling
+// every interval until ctx is done. offset is usually the last one sink
+// already holds, such as the last line ReadNDJSONFile reads back. A change
+// the sink fails to take is tried again on the next poll.
+func Follow(ctx context.Context, feed Feed, sink Sink, offset uint64, interval time.Duration) {
+	for {
+		changes, _, err := feed.Since(ctx, offset, followBatchSize)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("cdc: cannot follow the changes after offset %d: %v", offset, err)
+		}
+		written := 0
+		for _, change := range changes {
+			if err := sink.Write(ctx, change); err != nil {
+				log.Printf("cdc: change %d was not written to a sink: %v", change.Offset, err)
+				break
+			}
+			offset = change.Offset
+			written++
+		}
+		if written == followBatchSize {
+			continue
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
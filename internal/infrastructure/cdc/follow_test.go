@@ -0,0 +1,50 @@
+package cdc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+)
+
+func TestGivenAFeed_WhenFollowingIt_ThenShouldCopyTheChangesAfterTheOffsetOnceEach(t *testing.T) {
+	changes := cdc.NewLog()
+	appendN(t, changes, 3)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		written  []uint64
+		failures int
+	)
+	sink := sinkFunc(func(_ context.Context, change cdc.Change) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if change.Offset == 3 && failures == 0 {
+			failures++
+			return errors.New("disk full")
+		}
+		written = append(written, change.Offset)
+		return nil
+	})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cdc.Follow(ctx, changes, sink, 1, time.Millisecond)
+	}()
+	appendN(t, changes, 1)
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(written) == 3
+	}, time.Second, time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, []uint64{2, 3, 4}, written)
+}
@@ -0,0 +1,168 @@
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/gateway"
+)
+
+// Table describes how an aggregate appears in the change stream. Row builds
+// the image used for before and after; ChangedAt is when the entity was last
+// written, used as the source time of creates and updates.
+type Table[T any, ID comparable] struct {
+	Name        string
+	Key         func(entity *T) ID
+	Row         func(entity *T) any
+	ChangedAt   func(entity *T) time.Time
+	ErrNotFound error
+}
+
+// Envelope describes op on a row of the table given its images before and
+// after, either of which is nil when the row did not exist, captured at now.
+func (t Table[T, ID]) Envelope(op Op, before, after *T, now time.Time) (Envelope, error) {
+	envelope := Envelope{
+		Source: Source{Connector: connectorName, Name: sourceName, Table: t.Name, TsMs: now.UnixMilli()},
+		Op:     op,
+		TsMs:   now.UnixMilli(),
+	}
+	var err error
+	if envelope.Before, err = t.image(before); err != nil {
+		return Envelope{}, err
+	}
+	if envelope.After, err = t.image(after); err != nil {
+		return Envelope{}, err
+	}
+	if after != nil {
+		envelope.Source.TsMs = t.ChangedAt(after).UnixMilli()
+	}
+	return envelope, nil
+}
+
+func (t Table[T, ID]) image(entity *T) (json.RawMessage, error) {
+	if entity == nil {
+		return json.RawMessage("null"), nil
+	}
+	row, err := json.Marshal(t.Row(entity))
+	if err != nil {
+		return nil, fmt.Errorf("encode %s row: %w", t.Name, err)
+	}
+	return row, nil
+}
+
+// Gateway wraps a gateway.Gateway and appends a change to the log for every
+// successful create, update and delete. The before image is read from the
+// wrapped gateway ahead of the write; writes through the same Gateway are
+// serialized so the log follows their order. Reads go straight through.
+type Gateway[T any, ID comparable] struct {
+	gateway.Gateway[T, ID]
+	table Table[T, ID]
+	log   *Log
+	// sem serializes writes. It is a one-slot channel rather than a mutex so
+	// that a caller waiting for it can give up when its context is done.
+	sem chan struct{}
+}
+
+func NewGateway[T any, ID comparable](inner gateway.Gateway[T, ID], log *Log, table Table[T, ID]) *Gateway[T, ID] {
+	return &Gateway[T, ID]{
+		Gateway: inner,
+		table:   table,
+		log:     log,
+		sem:     make(chan struct{}, 1),
+	}
+}
+
+func (g *Gateway[T, ID]) Create(ctx context.Context, entity *T) (*T, error) {
+	if err := g.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer g.unlock()
+
+	created, err := g.Gateway.Create(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	g.capture(ctx, OpCreate, nil, created)
+	return created, nil
+}
+
+func (g *Gateway[T, ID]) Update(ctx context.Context, entity *T) (*T, error) {
+	if err := g.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer g.unlock()
+
+	before, err := g.before(ctx, g.table.Key(entity))
+	if err != nil {
+		return nil, err
+	}
+	updated, err := g.Gateway.Update(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	g.capture(ctx, OpUpdate, before, updated)
+	return updated, nil
+}
+
+// DeleteByID appends nothing when there was no row to delete.
+func (g *Gateway[T, ID]) DeleteByID(ctx context.Context, id ID, events ...event.Event) error {
+	if err := g.lock(ctx); err != nil {
+		return err
+	}
+	defer g.unlock()
+
+	before, err := g.before(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := g.Gateway.DeleteByID(ctx, id, events...); err != nil {
+		return err
+	}
+	if before != nil {
+		g.capture(ctx, OpDelete, before, nil)
+	}
+	return nil
+}
+
+// before reads the current row, or nil when there is none.
+func (g *Gateway[T, ID]) before(ctx context.Context, id ID) (*T, error) {
+	entity, err := g.Gateway.FindByID(ctx, id)
+	if errors.Is(err, g.table.ErrNotFound) {
+		return nil, nil
+	}
+	return entity, err
+}
+
+// capture runs once the write has succeeded, so the change is logged even if
+// ctx ends meanwhile. The write stands whatever happens here: a change that
+// cannot be encoded is reported instead of failing the caller.
+func (g *Gateway[T, ID]) capture(ctx context.Context, op Op, before, after *T) {
+	envelope, err := g.table.Envelope(op, before, after, g.log.Now())
+	if err != nil {
+		log.Printf("cdc: %s change to %s was not logged: %v", op, g.table.Name, err)
+		return
+	}
+	g.log.Append(context.WithoutCancel(ctx), envelope)
+}
+
+// lock waits for exclusive write access until ctx is done.
+func (g *Gateway[T, ID]) lock(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case g.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (g *Gateway[T, ID]) unlock() {
+	<-g.sem
+}
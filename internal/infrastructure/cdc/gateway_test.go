@@ -0,0 +1,106 @@
+package cdc_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+func categoryImage(t *testing.T, raw json.RawMessage) *cdc.CategoryRow {
+	t.Helper()
+	var row *cdc.CategoryRow
+	require.NoError(t, json.Unmarshal(raw, &row))
+	return row
+}
+
+func TestGivenACategory_WhenCreatedUpdatedAndDeleted_ThenShouldLogAnEnvelopeForEach(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	changes := cdc.NewLog(cdc.WithClock(timeutils.NewFakeClock(now)))
+	gateway := cdc.NewCategoryGateway(memory.NewCategoryGateway(), changes)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, c.Update(nil, "Séries", "", false))
+	_, err = gateway.Update(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+
+	logged, _, err := changes.Since(t.Context(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, logged, 3)
+
+	created := logged[0]
+	assert.Equal(t, uint64(1), created.Offset)
+	assert.Equal(t, cdc.OpCreate, created.Payload.Op)
+	assert.Nil(t, categoryImage(t, created.Payload.Before))
+	assert.Equal(t, cdc.NewCategoryRow(c).ID, categoryImage(t, created.Payload.After).ID)
+	assert.Equal(t, "Filmes", categoryImage(t, created.Payload.After).Name)
+	assert.Equal(t, cdc.Source{Connector: "admin-catalogo-video-go", Name: "catalog", Table: "categories", TsMs: c.CreatedAt.UnixMilli()}, created.Payload.Source)
+	assert.Equal(t, now.UnixMilli(), created.Payload.TsMs)
+
+	updated := logged[1]
+	assert.Equal(t, cdc.OpUpdate, updated.Payload.Op)
+	assert.Equal(t, "Filmes", categoryImage(t, updated.Payload.Before).Name)
+	assert.True(t, categoryImage(t, updated.Payload.Before).IsActive)
+	assert.Equal(t, cdc.NewCategoryRow(c), *categoryImage(t, updated.Payload.After))
+
+	deleted := logged[2]
+	assert.Equal(t, cdc.OpDelete, deleted.Payload.Op)
+	assert.Equal(t, cdc.NewCategoryRow(c), *categoryImage(t, deleted.Payload.Before))
+	assert.Nil(t, categoryImage(t, deleted.Payload.After))
+	assert.JSONEq(t, "null", string(deleted.Payload.After))
+}
+
+func TestGivenAMissingCategory_WhenCallDeleteByID_ThenShouldLogNothing(t *testing.T) {
+	changes := cdc.NewLog()
+	gateway := cdc.NewCategoryGateway(memory.NewCategoryGateway(), changes)
+
+	require.NoError(t, gateway.DeleteByID(t.Context(), category.NewCategoryID()))
+
+	assert.Zero(t, changes.Last())
+}
+
+func TestGivenAFailedWrite_WhenCallUpdate_ThenShouldLogNothing(t *testing.T) {
+	changes := cdc.NewLog()
+	gateway := cdc.NewCastMemberGateway(memory.NewCastMemberGateway(), changes)
+	c, err := castmember.NewCastMember(nil, "Keanu", castmember.Actor)
+	require.NoError(t, err)
+
+	_, err = gateway.Update(t.Context(), c)
+
+	assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+	assert.Zero(t, changes.Last())
+}
+
+func TestGivenACastMember_WhenCallCreate_ThenShouldLogItsRow(t *testing.T) {
+	changes := cdc.NewLog()
+	gateway := cdc.NewCastMemberGateway(memory.NewCastMemberGateway(), changes)
+	c, err := castmember.NewCastMember(nil, "Keanu", castmember.Actor)
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	logged, _, err := changes.Since(t.Context(), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, logged, 1)
+	assert.Equal(t, "cast_members", logged[0].Payload.Source.Table)
+	assert.JSONEq(t, `{
+		"id": "`+c.ID.String()+`",
+		"name": "Keanu",
+		"type": "ACTOR",
+		"created_at": `+strconv.FormatInt(c.CreatedAt.UnixMicro(), 10)+`,
+		"updated_at": `+strconv.FormatInt(c.UpdatedAt.UnixMicro(), 10)+`
+	}`, string(logged[0].Payload.After))
+}
@@ -0,0 +1,165 @@
+package cdc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+var (
+	// ErrOffsetExpired is returned for an offset whose following changes are
+	// no longer retained; the reader has to resync from a full listing.
+	ErrOffsetExpired = errors.New("the changes after this offset are no longer retained")
+	// ErrOffsetAhead is returned for an offset past the latest change, which
+	// the reader cannot have seen from this log; it has to resync as well.
+	ErrOffsetAhead = errors.New("the offset is past the latest change")
+)
+
+const defaultRetention = 10_000
+
+// Feed is where readers catch up with the change stream. Since returns up to
+// limit changes after offset, oldest first, along with the offset of the
+// latest change; it fails with ErrOffsetExpired or ErrOffsetAhead when the
+// reader has to resync.
+type Feed interface {
+	Since(ctx context.Context, offset uint64, limit int) ([]Change, uint64, error)
+}
+
+// Sink receives every change appended to a Log, in offset order.
+type Sink interface {
+	Write(ctx context.Context, change Change) error
+}
+
+// SinkFailure describes a change a sink could not take.
+type SinkFailure struct {
+	Change Change
+	Err    error
+}
+
+type LogOption func(l *Log)
+
+// WithRetention sets how many of the latest changes the log keeps for Since.
+func WithRetention(changes int) LogOption {
+	return func(l *Log) { l.retention = max(changes, 1) }
+}
+
+// WithHistory resumes the log after changes, given in offset order, such as
+// the ones ReadNDJSONFile reads back, so offsets carry on across restarts.
+func WithHistory(changes []Change) LogOption {
+	return func(l *Log) {
+		l.changes = append([]Change(nil), changes...)
+		if len(changes) > 0 {
+			l.last = changes[len(changes)-1].Offset
+		}
+	}
+}
+
+func WithSinks(sinks ...Sink) LogOption {
+	return func(l *Log) { l.sinks = append(l.sinks, sinks...) }
+}
+
+// WithClock replaces the system clock changes are stamped with.
+func WithClock(clock timeutils.Clock) LogOption {
+	return func(l *Log) { l.clock = clock }
+}
+
+// WithSinkFailureHandler replaces the default, which logs, as the place
+// failed sink writes are reported.
+func WithSinkFailureHandler(onFailure func(SinkFailure)) LogOption {
+	return func(l *Log) { l.onSinkFailure = onFailure }
+}
+
+// Log numbers changes with consecutive offsets starting at 1, or after its
+// history, keeps the latest of them in memory for readers to catch up with,
+// and copies each one to its sinks. It is safe for concurrent use.
+type Log struct {
+	mu            sync.Mutex
+	changes       []Change
+	last          uint64
+	retention     int
+	sinks         []Sink
+	onSinkFailure func(SinkFailure)
+	clock         timeutils.Clock
+}
+
+func NewLog(options ...LogOption) *Log {
+	l := &Log{
+		retention:     defaultRetention,
+		onSinkFailure: logSinkFailure,
+	}
+	for _, option := range options {
+		option(l)
+	}
+	l.trim()
+	return l
+}
+
+// Append gives envelope the next offset. A sink that fails is reported and
+// does not stop the change from being logged or reaching the other sinks.
+func (l *Log) Append(ctx context.Context, envelope Envelope) Change {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.last++
+	change := Change{Offset: l.last, Payload: envelope}
+	l.changes = append(l.changes, change)
+	l.trim()
+
+	for _, sink := range l.sinks {
+		if err := sink.Write(ctx, change); err != nil {
+			l.onSinkFailure(SinkFailure{Change: change, Err: err})
+		}
+	}
+	return change
+}
+
+// trim drops the changes beyond the retention, oldest first.
+func (l *Log) trim() {
+	if excess := len(l.changes) - l.retention; excess > 0 {
+		l.changes = append(l.changes[:0:0], l.changes[excess:]...)
+	}
+}
+
+var _ Feed = (*Log)(nil)
+
+// Since returns up to limit changes after offset, oldest first, and the
+// offset of the latest change, which is also where a reader told to resync
+// resumes from. Offset 0 reads from the start of the log.
+func (l *Log) Since(_ context.Context, offset uint64, limit int) ([]Change, uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if offset > l.last {
+		return nil, l.last, ErrOffsetAhead
+	}
+	if offset == l.last {
+		return []Change{}, l.last, nil
+	}
+	first := l.changes[0].Offset
+	if offset+1 < first {
+		return nil, l.last, ErrOffsetExpired
+	}
+	start := int(offset + 1 - first)
+	end := min(start+max(limit, 0), len(l.changes))
+	return append([]Change{}, l.changes[start:end]...), l.last, nil
+}
+
+// Now reads the clock the log stamps changes with.
+func (l *Log) Now() time.Time {
+	return timeutils.Now(l.clock)
+}
+
+// Last is the offset of the latest change, 0 while the log is empty.
+func (l *Log) Last() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.last
+}
+
+func logSinkFailure(f SinkFailure) {
+	log.Printf("cdc: change %d was not written to a sink: %v", f.Change.Offset, f.Err)
+}
@@ -0,0 +1,111 @@
+package cdc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+)
+
+type failingSink struct{}
+
+func (failingSink) Write(context.Context, cdc.Change) error {
+	return errors.New("disk full")
+}
+
+type sinkFunc func(ctx context.Context, change cdc.Change) error
+
+func (f sinkFunc) Write(ctx context.Context, change cdc.Change) error {
+	return f(ctx, change)
+}
+
+func appendN(t *testing.T, changes *cdc.Log, n int) {
+	t.Helper()
+	for range n {
+		changes.Append(t.Context(), cdc.Envelope{Op: cdc.OpCreate})
+	}
+}
+
+func offsets(changes []cdc.Change) []uint64 {
+	result := make([]uint64, len(changes))
+	for i, change := range changes {
+		result[i] = change.Offset
+	}
+	return result
+}
+
+func TestGivenAppendedChanges_WhenCallSince_ThenShouldReturnTheOnesAfterTheOffset(t *testing.T) {
+	changes := cdc.NewLog()
+	appendN(t, changes, 5)
+
+	after2, _, err := changes.Since(t.Context(), 2, 2)
+	require.NoError(t, err)
+	all, _, err := changes.Since(t.Context(), 0, 100)
+	require.NoError(t, err)
+	none, _, err := changes.Since(t.Context(), 5, 100)
+	require.NoError(t, err)
+
+	assert.Equal(t, []uint64{3, 4}, offsets(after2))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5}, offsets(all))
+	assert.Empty(t, none)
+	assert.Equal(t, uint64(5), changes.Last())
+}
+
+func TestGivenARetentionLimit_WhenReadingPastIt_ThenShouldReturnErrOffsetExpired(t *testing.T) {
+	changes := cdc.NewLog(cdc.WithRetention(2))
+	appendN(t, changes, 5)
+
+	_, last, err := changes.Since(t.Context(), 2, 10)
+	assert.ErrorIs(t, err, cdc.ErrOffsetExpired)
+	assert.Equal(t, uint64(5), last)
+
+	retained, _, err := changes.Since(t.Context(), 3, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 5}, offsets(retained))
+}
+
+func TestGivenAnOffsetPastTheLatestChange_WhenCallSince_ThenShouldReturnErrOffsetAhead(t *testing.T) {
+	changes := cdc.NewLog()
+	appendN(t, changes, 2)
+
+	_, _, err := changes.Since(t.Context(), 3, 10)
+
+	assert.ErrorIs(t, err, cdc.ErrOffsetAhead)
+}
+
+func TestGivenAHistory_WhenCallAppend_ThenShouldCarryOnFromItsLastOffset(t *testing.T) {
+	history := []cdc.Change{{Offset: 7}, {Offset: 8}, {Offset: 9}}
+	changes := cdc.NewLog(cdc.WithHistory(history), cdc.WithRetention(2))
+
+	appended := changes.Append(t.Context(), cdc.Envelope{Op: cdc.OpCreate})
+
+	assert.Equal(t, uint64(10), appended.Offset)
+	retained, _, err := changes.Since(t.Context(), 8, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{9, 10}, offsets(retained))
+	_, _, err = changes.Since(t.Context(), 7, 10)
+	assert.ErrorIs(t, err, cdc.ErrOffsetExpired)
+}
+
+func TestGivenSinks_WhenCallAppend_ThenShouldWriteToEachAndReportFailures(t *testing.T) {
+	var failures []cdc.SinkFailure
+	var written []cdc.Change
+	recording := sinkFunc(func(_ context.Context, change cdc.Change) error {
+		written = append(written, change)
+		return nil
+	})
+	changes := cdc.NewLog(
+		cdc.WithSinks(failingSink{}, recording),
+		cdc.WithSinkFailureHandler(func(f cdc.SinkFailure) { failures = append(failures, f) }),
+	)
+
+	change := changes.Append(t.Context(), cdc.Envelope{Op: cdc.OpDelete})
+
+	assert.Equal(t, []cdc.Change{change}, written)
+	require.Len(t, failures, 1)
+	assert.Equal(t, uint64(1), failures[0].Change.Offset)
+	assert.EqualError(t, failures[0].Err, "disk full")
+}
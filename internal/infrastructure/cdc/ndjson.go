@@ -0,0 +1,96 @@
+package cdc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// NDJSONSink writes each change as one line of JSON.
+type NDJSONSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+func NewNDJSONSink(w io.Writer) *NDJSONSink {
+	return &NDJSONSink{w: w}
+}
+
+// OpenNDJSONFile appends to the file at path, creating it if needed.
+func OpenNDJSONFile(path string) (*NDJSONSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &NDJSONSink{w: file, closer: file}, nil
+}
+
+func (s *NDJSONSink) Write(_ context.Context, change Change) error {
+	line, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
+
+// Close closes the file opened by OpenNDJSONFile. It does nothing for a sink
+// made with NewNDJSONSink, whose writer belongs to the caller.
+func (s *NDJSONSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// ReadNDJSONFile reads back the changes written to the file at path and
+// returns the latest keep of them. A missing file holds no changes; a line
+// that is not a change, such as one cut short by a crash, is an error.
+func ReadNDJSONFile(path string, keep int) ([]Change, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	keep = max(keep, 1)
+	var changes []Change
+	reader := bufio.NewReader(file)
+	for number := 1; ; number++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var change Change
+			if err := json.Unmarshal(line, &change); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, number, err)
+			}
+			changes = append(changes, change)
+			if len(changes) >= 2*keep {
+				changes = append(changes[:0:0], changes[len(changes)-keep:]...)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if excess := len(changes) - keep; excess > 0 {
+		changes = changes[excess:]
+	}
+	return changes, nil
+}
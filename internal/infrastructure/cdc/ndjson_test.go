@@ -0,0 +1,99 @@
+package cdc_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+)
+
+func TestGivenAnNDJSONFile_WhenChangesAreAppended_ThenShouldWriteOneLinePerChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.ndjson")
+	sink, err := cdc.OpenNDJSONFile(path)
+	require.NoError(t, err)
+	changes := cdc.NewLog(cdc.WithSinks(sink))
+
+	changes.Append(t.Context(), cdc.Envelope{
+		Before: json.RawMessage("null"),
+		After:  json.RawMessage(`{"id":"1"}`),
+		Source: cdc.Source{Table: "categories"},
+		Op:     cdc.OpCreate,
+		TsMs:   1700000000000,
+	})
+	changes.Append(t.Context(), cdc.Envelope{
+		Before: json.RawMessage(`{"id":"1"}`),
+		After:  json.RawMessage("null"),
+		Op:     cdc.OpDelete,
+	})
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"offset": 1,
+		"payload": {
+			"before": null,
+			"after": {"id": "1"},
+			"source": {"connector": "", "name": "", "table": "categories", "ts_ms": 0},
+			"op": "c",
+			"ts_ms": 1700000000000
+		}
+	}`, lines[0])
+	var second cdc.Change
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &second))
+	assert.Equal(t, uint64(2), second.Offset)
+	assert.Equal(t, cdc.OpDelete, second.Payload.Op)
+}
+
+func TestGivenAnNDJSONFile_WhenTheLogRestarts_ThenShouldResumeItsOffsets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.ndjson")
+	sink, err := cdc.OpenNDJSONFile(path)
+	require.NoError(t, err)
+	before := cdc.NewLog(cdc.WithSinks(sink))
+	appendN(t, before, 3)
+	require.NoError(t, sink.Close())
+
+	history, err := cdc.ReadNDJSONFile(path, 2)
+	require.NoError(t, err)
+	sink, err = cdc.OpenNDJSONFile(path)
+	require.NoError(t, err)
+	defer sink.Close()
+	after := cdc.NewLog(cdc.WithHistory(history), cdc.WithSinks(sink))
+
+	assert.Equal(t, []uint64{2, 3}, offsets(history))
+	assert.Equal(t, uint64(4), after.Append(t.Context(), cdc.Envelope{Op: cdc.OpCreate}).Offset)
+	resumed, _, err := after.Since(t.Context(), 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4}, offsets(resumed))
+
+	all, err := cdc.ReadNDJSONFile(path, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2, 3, 4}, offsets(all))
+}
+
+func TestGivenAMissingOrCorruptNDJSONFile_WhenCallReadNDJSONFile_ThenShouldReturnNothingOrAnError(t *testing.T) {
+	dir := t.TempDir()
+
+	history, err := cdc.ReadNDJSONFile(filepath.Join(dir, "missing.ndjson"), 10)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	corrupt := filepath.Join(dir, "corrupt.ndjson")
+	require.NoError(t, os.WriteFile(corrupt, []byte("{\"offset\":1,\"payload\":{}}\n{\"offset\":2,\"pay"), 0o644))
+	_, err = cdc.ReadNDJSONFile(corrupt, 10)
+	assert.ErrorContains(t, err, "corrupt.ndjson:2")
+}
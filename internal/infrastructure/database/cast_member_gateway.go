@@ -0,0 +1,246 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+const castMemberColumns = `id, name, type, created_at, updated_at`
+
+var (
+	castMemberName     = textColumn("name_key", func(c *castmember.CastMember) string { return c.Name })
+	castMemberNameSort = sortKey[castmember.CastMember]{castMemberName}
+	castMemberSorts    = map[string]sortKey[castmember.CastMember]{
+		"":     castMemberNameSort,
+		"name": castMemberNameSort,
+		"type": {
+			enumColumn("type", func(c *castmember.CastMember) string { return string(c.Type) }),
+			castMemberName,
+		},
+		"created_at": {
+			timeColumn("created_at", func(c *castmember.CastMember) time.Time { return c.CreatedAt }),
+		},
+	}
+	castMemberTable = table[castmember.CastMember]{
+		name:    "cast_members",
+		columns: castMemberColumns,
+		scan:    scanCastMember,
+		id:      func(c *castmember.CastMember) string { return c.ID.String() },
+	}
+)
+
+type CastMemberGateway struct {
+	db    *sql.DB
+	clock timeutils.Clock
+}
+
+var _ castmember.CastMemberGateway = (*CastMemberGateway)(nil)
+
+func NewCastMemberGateway(db *sql.DB) *CastMemberGateway {
+	return &CastMemberGateway{db: db}
+}
+
+// WithClock replaces the system clock the outbox rows and changes it writes
+// are stamped with.
+func (g *CastMemberGateway) WithClock(clock timeutils.Clock) *CastMemberGateway {
+	g.clock = clock
+	return g
+}
+
+func (g *CastMemberGateway) Create(ctx context.Context, c *castmember.CastMember) (*castmember.CastMember, error) {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO cast_members (`+castMemberColumns+`, name_key) VALUES ($1, $2, $3, $4, $5, $6)`,
+			c.ID, c.Name, string(c.Type), c.CreatedAt.UTC(), c.UpdatedAt.UTC(), pagination.TextKey(c.Name),
+		); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, c.PendingEvents()); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CastMemberTable, cdc.OpCreate, nil, c)
+	})
+	if err != nil {
+		if exists, existsErr := g.exists(ctx, c.ID); existsErr == nil && exists {
+			return nil, castmember.ErrCastMemberAlreadyExists
+		}
+		return nil, queryError(ctx, "insert cast member", err)
+	}
+	return saved(c), nil
+}
+
+func (g *CastMemberGateway) Update(ctx context.Context, c *castmember.CastMember) (*castmember.CastMember, error) {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		exists, err := claimRow(ctx, tx, "cast_members", c.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return castmember.ErrCastMemberNotFound
+		}
+		before, err := scanCastMember(tx.QueryRowContext(ctx, `SELECT `+castMemberColumns+` FROM cast_members WHERE id = $1`, c.ID))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`UPDATE cast_members SET name = $1, name_key = $2, type = $3, created_at = $4, updated_at = $5 WHERE id = $6`,
+			c.Name, pagination.TextKey(c.Name), string(c.Type), c.CreatedAt.UTC(), c.UpdatedAt.UTC(), c.ID,
+		); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, c.PendingEvents()); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CastMemberTable, cdc.OpUpdate, before, c)
+	})
+	if errors.Is(err, castmember.ErrCastMemberNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, queryError(ctx, "update cast member", err)
+	}
+	return saved(c), nil
+}
+
+// DeleteByID writes events to the outbox only when a row was removed.
+func (g *CastMemberGateway) DeleteByID(ctx context.Context, id castmember.CastMemberID, events ...event.Event) error {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		exists, err := claimRow(ctx, tx, "cast_members", id)
+		if err != nil || !exists {
+			return err
+		}
+		before, err := scanCastMember(tx.QueryRowContext(ctx, `SELECT `+castMemberColumns+` FROM cast_members WHERE id = $1`, id))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM cast_members WHERE id = $1`, id); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, events); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CastMemberTable, cdc.OpDelete, before, nil)
+	})
+	if err != nil {
+		return queryError(ctx, "delete cast member", err)
+	}
+	return nil
+}
+
+func (g *CastMemberGateway) FindByID(ctx context.Context, id castmember.CastMemberID) (*castmember.CastMember, error) {
+	c, err := scanCastMember(g.db.QueryRowContext(ctx, `SELECT `+castMemberColumns+` FROM cast_members WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, castmember.ErrCastMemberNotFound
+	}
+	if err != nil {
+		return nil, queryError(ctx, "find cast member", err)
+	}
+	return c, nil
+}
+
+func (g *CastMemberGateway) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[castmember.CastMember], error) {
+	sorting, desc, err := castMemberSearch(query)
+	if err != nil {
+		return nil, err
+	}
+	where := castMemberConditions(query)
+
+	var total int64
+	if err := g.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM cast_members`+where.String(), where.args...).Scan(&total); err != nil {
+		return nil, queryError(ctx, "count cast members", err)
+	}
+	if !pageBounds(query, total) {
+		return pagination.New[castmember.CastMember](query, total, nil)
+	}
+
+	conditions := where.String()
+	limit, offset := where.arg(query.PerPage), where.arg(query.Page*query.PerPage)
+	rows, err := g.db.QueryContext(
+		ctx,
+		`SELECT `+castMemberColumns+` FROM cast_members`+conditions+` `+orderBy(sorting.exprs(), desc, false)+` LIMIT `+limit+` OFFSET `+offset,
+		where.args...,
+	)
+	if err != nil {
+		return nil, queryError(ctx, "list cast members", err)
+	}
+	defer rows.Close()
+
+	items := make([]castmember.CastMember, 0, query.PerPage)
+	for rows.Next() {
+		c, err := scanCastMember(rows)
+		if err != nil {
+			return nil, queryError(ctx, "list cast members", err)
+		}
+		items = append(items, *c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, "list cast members", err)
+	}
+	return pagination.New(query, total, items)
+}
+
+func (g *CastMemberGateway) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[castmember.CastMember], error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	sorting, desc, err := castMemberSearch(query.SearchQuery())
+	if err != nil {
+		return nil, err
+	}
+	return findByCursor(ctx, g.db, castMemberTable, castMemberConditions(query.SearchQuery()), sorting, desc, query)
+}
+
+func castMemberSearch(query pagination.SearchQuery) (sortKey[castmember.CastMember], bool, error) {
+	if err := query.CheckSort(castmember.SearchRules.Sorts...); err != nil {
+		return nil, false, err
+	}
+	sorting := castMemberSorts[query.Sort]
+	desc, err := query.IsDescending()
+	if err != nil {
+		return nil, false, err
+	}
+	if err := query.CheckFilters(castmember.SearchRules.Filters...); err != nil {
+		return nil, false, err
+	}
+	return sorting, desc, nil
+}
+
+func castMemberConditions(query pagination.SearchQuery) whereClause {
+	var where whereClause
+	if castMemberType := strings.ToUpper(strings.TrimSpace(query.Filters["type"])); castMemberType != "" {
+		where.add("type = " + where.arg(castMemberType))
+	}
+	if pattern := likePattern(query.Terms); pattern != "" {
+		where.add("name_key LIKE " + where.arg(pattern) + " ESCAPE '\\'")
+	}
+	return where
+}
+
+func (g *CastMemberGateway) exists(ctx context.Context, id castmember.CastMemberID) (bool, error) {
+	var count int
+	err := g.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM cast_members WHERE id = $1`, id).Scan(&count)
+	return count > 0, err
+}
+
+func scanCastMember(row rowScanner) (*castmember.CastMember, error) {
+	var (
+		c              castmember.CastMember
+		castMemberType string
+	)
+	if err := row.Scan(&c.ID, &c.Name, &castMemberType, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	c.Type = castmember.CastMemberType(castMemberType)
+	return &c, nil
+}
@@ -0,0 +1,151 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+	gatewaytest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/gateway-test"
+)
+
+func newCastMember(t *testing.T, name string, castMemberType castmember.CastMemberType) *castmember.CastMember {
+	t.Helper()
+	c, err := castmember.NewCastMember(nil, name, castMemberType)
+	require.NoError(t, err)
+	return c
+}
+
+func seedCastMembers(t *testing.T, gateway *database.CastMemberGateway, castMembers ...*castmember.CastMember) {
+	t.Helper()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, c := range castMembers {
+		c.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		c.UpdatedAt = c.CreatedAt
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+}
+
+func TestCastMemberGatewaySuite(t *testing.T) {
+	gatewaytest.RunCastMemberGatewaySuite(t, func(t *testing.T) castmember.CastMemberGateway {
+		return database.NewCastMemberGateway(newDB(t))
+	})
+}
+
+func TestGivenAValidCastMember_WhenCallCreateAndFindByID_ThenShouldRoundTrip(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+	c := newCastMember(t, "Vin Diesel", castmember.Actor)
+
+	_, err := gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	found, err := gateway.FindByID(t.Context(), c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, c.ID, found.ID)
+	assert.Equal(t, "Vin Diesel", found.Name)
+	assert.Equal(t, castmember.Actor, found.Type)
+	assert.True(t, c.CreatedAt.Equal(found.CreatedAt))
+
+	_, err = gateway.Create(t.Context(), c)
+	assert.ErrorIs(t, err, castmember.ErrCastMemberAlreadyExists)
+}
+
+func TestGivenAnUnknownCastMember_WhenCallFindByIDOrUpdate_ThenShouldReceiveNotFound(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+	c := newCastMember(t, "Vin Diesel", castmember.Actor)
+
+	_, err := gateway.FindByID(t.Context(), c.ID)
+	assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+
+	_, err = gateway.Update(t.Context(), c)
+	assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+}
+
+func TestGivenAnExistingCastMember_WhenCallUpdateAndDelete_ThenShouldPersistChanges(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+	c := newCastMember(t, "Vin Diesel", castmember.Actor)
+	_, err := gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Update(nil, "Greta Gerwig", castmember.Director))
+	_, err = gateway.Update(t.Context(), c)
+	require.NoError(t, err)
+
+	found, err := gateway.FindByID(t.Context(), c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Greta Gerwig", found.Name)
+	assert.Equal(t, castmember.Director, found.Type)
+
+	require.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+	_, err = gateway.FindByID(t.Context(), c.ID)
+	assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+}
+
+func TestGivenAnUnknownType_WhenInsertingDirectly_ThenShouldViolateTheCheckConstraint(t *testing.T) {
+	db := newDB(t)
+
+	_, err := db.Exec(
+		`INSERT INTO cast_members (id, name, type, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)`,
+		castmember.NewCastMemberID(), "Vin Diesel", "PRODUCER", time.Now().UTC(),
+	)
+
+	assert.Error(t, err)
+}
+
+func TestGivenCastMembers_WhenCallFindAllFilteredByTypeAndTerms_ThenShouldReturnMatches(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+	seedCastMembers(t, gateway,
+		newCastMember(t, "Vin Diesel", castmember.Actor),
+		newCastMember(t, "Martin Scorsese", castmember.Director),
+		newCastMember(t, "Kevin Costner", castmember.Director),
+		newCastMember(t, "Greta Gerwig", castmember.Director),
+	)
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{
+		PerPage: 10,
+		Terms:   "IN",
+		Filters: map[string]string{"type": "director"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, []string{"Kevin Costner", "Martin Scorsese"}, castMemberNames(result.Items))
+}
+
+func TestGivenCastMembers_WhenCallFindAllSortedByTypeDesc_ThenShouldOrderByTypeThenName(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+	seedCastMembers(t, gateway,
+		newCastMember(t, "Vin Diesel", castmember.Actor),
+		newCastMember(t, "Martin Scorsese", castmember.Director),
+		newCastMember(t, "Keanu Reeves", castmember.Actor),
+		newCastMember(t, "Greta Gerwig", castmember.Director),
+	)
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{Page: 0, PerPage: 3, Sort: "type", Direction: "desc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), result.Total)
+	assert.Equal(t, []string{"Martin Scorsese", "Greta Gerwig", "Vin Diesel"}, castMemberNames(result.Items))
+}
+
+func TestGivenAnInvalidSortOrFilter_WhenCallFindAllCastMembers_ThenShouldReceiveAnError(t *testing.T) {
+	gateway := database.NewCastMemberGateway(newDB(t))
+
+	_, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: "updated_at"})
+	assert.Error(t, err)
+
+	_, err = gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Filters: map[string]string{"age": "30"}})
+	assert.Error(t, err)
+}
+
+func castMemberNames(items []castmember.CastMember) []string {
+	names := make([]string, len(items))
+	for i, c := range items {
+		names[i] = c.Name
+	}
+	return names
+}
@@ -0,0 +1,245 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+const categoryColumns = `id, name, description, is_active, created_at, updated_at, deleted_at`
+
+var (
+	categoryNameSort = sortKey[category.Category]{
+		textColumn("name_key", func(c *category.Category) string { return c.Name }),
+	}
+	categorySorts = map[string]sortKey[category.Category]{
+		"":     categoryNameSort,
+		"name": categoryNameSort,
+		"created_at": {
+			timeColumn("created_at", func(c *category.Category) time.Time { return c.CreatedAt }),
+		},
+		"updated_at": {
+			timeColumn("updated_at", func(c *category.Category) time.Time { return c.UpdatedAt }),
+		},
+	}
+	categoryTable = table[category.Category]{
+		name:    "categories",
+		columns: categoryColumns,
+		scan:    scanCategory,
+		id:      func(c *category.Category) string { return c.ID.String() },
+	}
+)
+
+type CategoryGateway struct {
+	db    *sql.DB
+	clock timeutils.Clock
+}
+
+var _ category.CategoryGateway = (*CategoryGateway)(nil)
+
+func NewCategoryGateway(db *sql.DB) *CategoryGateway {
+	return &CategoryGateway{db: db}
+}
+
+// WithClock replaces the system clock the outbox rows and changes it writes
+// are stamped with.
+func (g *CategoryGateway) WithClock(clock timeutils.Clock) *CategoryGateway {
+	g.clock = clock
+	return g
+}
+
+func (g *CategoryGateway) Create(ctx context.Context, c *category.Category) (*category.Category, error) {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO categories (`+categoryColumns+`, name_key, description_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			c.ID, c.Name, c.Description, c.Active, c.CreatedAt.UTC(), c.UpdatedAt.UTC(), utcOrNil(c.DeletedAt), pagination.TextKey(c.Name), pagination.TextKey(c.Description),
+		); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, c.PendingEvents()); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CategoryTable, cdc.OpCreate, nil, c)
+	})
+	if err != nil {
+		if exists, existsErr := g.exists(ctx, c.ID); existsErr == nil && exists {
+			return nil, category.ErrCategoryAlreadyExists
+		}
+		return nil, queryError(ctx, "insert category", err)
+	}
+	return saved(c), nil
+}
+
+func (g *CategoryGateway) Update(ctx context.Context, c *category.Category) (*category.Category, error) {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		exists, err := claimRow(ctx, tx, "categories", c.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return category.ErrCategoryNotFound
+		}
+		before, err := scanCategory(tx.QueryRowContext(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id = $1`, c.ID))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`UPDATE categories SET name = $1, name_key = $2, description = $3, description_key = $4, is_active = $5, created_at = $6, updated_at = $7, deleted_at = $8 WHERE id = $9`,
+			c.Name, pagination.TextKey(c.Name), c.Description, pagination.TextKey(c.Description), c.Active, c.CreatedAt.UTC(), c.UpdatedAt.UTC(), utcOrNil(c.DeletedAt), c.ID,
+		); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, c.PendingEvents()); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CategoryTable, cdc.OpUpdate, before, c)
+	})
+	if errors.Is(err, category.ErrCategoryNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, queryError(ctx, "update category", err)
+	}
+	return saved(c), nil
+}
+
+// DeleteByID writes events to the outbox only when a row was removed.
+func (g *CategoryGateway) DeleteByID(ctx context.Context, id category.CategoryID, events ...event.Event) error {
+	err := inTx(ctx, g.db, func(tx *sql.Tx) error {
+		exists, err := claimRow(ctx, tx, "categories", id)
+		if err != nil || !exists {
+			return err
+		}
+		before, err := scanCategory(tx.QueryRowContext(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id = $1`, id))
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM categories WHERE id = $1`, id); err != nil {
+			return err
+		}
+		if err := writeOutbox(ctx, tx, g.clock, events); err != nil {
+			return err
+		}
+		return writeChange(ctx, tx, g.clock, cdc.CategoryTable, cdc.OpDelete, before, nil)
+	})
+	if err != nil {
+		return queryError(ctx, "delete category", err)
+	}
+	return nil
+}
+
+func (g *CategoryGateway) FindByID(ctx context.Context, id category.CategoryID) (*category.Category, error) {
+	c, err := scanCategory(g.db.QueryRowContext(ctx, `SELECT `+categoryColumns+` FROM categories WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, category.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, queryError(ctx, "find category", err)
+	}
+	return c, nil
+}
+
+func (g *CategoryGateway) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[category.Category], error) {
+	sorting, desc, err := categorySearch(query)
+	if err != nil {
+		return nil, err
+	}
+	where := categoryConditions(query)
+
+	var total int64
+	if err := g.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM categories`+where.String(), where.args...).Scan(&total); err != nil {
+		return nil, queryError(ctx, "count categories", err)
+	}
+	if !pageBounds(query, total) {
+		return pagination.New[category.Category](query, total, nil)
+	}
+
+	conditions := where.String()
+	limit, offset := where.arg(query.PerPage), where.arg(query.Page*query.PerPage)
+	rows, err := g.db.QueryContext(
+		ctx,
+		`SELECT `+categoryColumns+` FROM categories`+conditions+` `+orderBy(sorting.exprs(), desc, false)+` LIMIT `+limit+` OFFSET `+offset,
+		where.args...,
+	)
+	if err != nil {
+		return nil, queryError(ctx, "list categories", err)
+	}
+	defer rows.Close()
+
+	items := make([]category.Category, 0, query.PerPage)
+	for rows.Next() {
+		c, err := scanCategory(rows)
+		if err != nil {
+			return nil, queryError(ctx, "list categories", err)
+		}
+		items = append(items, *c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, "list categories", err)
+	}
+	return pagination.New(query, total, items)
+}
+
+func (g *CategoryGateway) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[category.Category], error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	sorting, desc, err := categorySearch(query.SearchQuery())
+	if err != nil {
+		return nil, err
+	}
+	return findByCursor(ctx, g.db, categoryTable, categoryConditions(query.SearchQuery()), sorting, desc, query)
+}
+
+func categorySearch(query pagination.SearchQuery) (sortKey[category.Category], bool, error) {
+	if err := query.CheckSort(category.SearchRules.Sorts...); err != nil {
+		return nil, false, err
+	}
+	sorting := categorySorts[query.Sort]
+	desc, err := query.IsDescending()
+	if err != nil {
+		return nil, false, err
+	}
+	if err := query.CheckFilters(category.SearchRules.Filters...); err != nil {
+		return nil, false, err
+	}
+	return sorting, desc, nil
+}
+
+func categoryConditions(query pagination.SearchQuery) whereClause {
+	var where whereClause
+	if pattern := likePattern(query.Terms); pattern != "" {
+		p := where.arg(pattern)
+		where.add("(name_key LIKE " + p + " ESCAPE '\\' OR description_key LIKE " + p + " ESCAPE '\\')")
+	}
+	return where
+}
+
+func (g *CategoryGateway) exists(ctx context.Context, id category.CategoryID) (bool, error) {
+	var count int
+	err := g.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM categories WHERE id = $1`, id).Scan(&count)
+	return count > 0, err
+}
+
+func scanCategory(row rowScanner) (*category.Category, error) {
+	var (
+		c         category.Category
+		deletedAt sql.NullTime
+	)
+	if err := row.Scan(&c.ID, &c.Name, &c.Description, &c.Active, &c.CreatedAt, &c.UpdatedAt, &deletedAt); err != nil {
+		return nil, err
+	}
+	if deletedAt.Valid {
+		c.DeletedAt = &deletedAt.Time
+	}
+	return &c, nil
+}
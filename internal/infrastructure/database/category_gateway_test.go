@@ -0,0 +1,89 @@
+package database_test
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+	gatewaytest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/gateway-test"
+)
+
+func newDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "catalog.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, database.Migrate(db))
+	return db
+}
+
+func newCategory(t *testing.T, name, description string, active bool) *category.Category {
+	t.Helper()
+	c, err := category.NewCategory(nil, name, description, active)
+	require.NoError(t, err)
+	return c
+}
+
+func TestCategoryGatewaySuite(t *testing.T) {
+	gatewaytest.RunCategoryGatewaySuite(t, func(t *testing.T) category.CategoryGateway {
+		return database.NewCategoryGateway(newDB(t))
+	})
+}
+
+func TestGivenACategoryInALocalZone_WhenCallCreate_ThenShouldStoreUTC(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	c.CreatedAt = c.CreatedAt.In(time.FixedZone("BRT", -3*60*60))
+
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	found, err := gateway.FindByID(t.Context(), c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, found.CreatedAt.Location())
+	assert.True(t, c.CreatedAt.Equal(found.CreatedAt))
+}
+
+func TestGivenNoFreeConnection_WhenTheDeadlinePasses_ThenShouldAbandonTheQuery(t *testing.T) {
+	db := newDB(t)
+	db.SetMaxOpenConns(1)
+	conn, err := db.Conn(t.Context())
+	require.NoError(t, err)
+	defer conn.Close()
+	gateway := database.NewCategoryGateway(db)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+
+	_, err = gateway.FindAll(ctx, pagination.SearchQuery{PerPage: 10})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestGivenEverySortInTheSearchRules_WhenCallFindAll_ThenTheGatewaysShouldSupportIt(t *testing.T) {
+	db := newDB(t)
+	categories := database.NewCategoryGateway(db)
+	castMembers := database.NewCastMemberGateway(db)
+
+	for _, sort := range category.SearchRules.Sorts {
+		_, err := categories.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: sort})
+		assert.NoError(t, err, "category sort %q", sort)
+	}
+	for _, sort := range castmember.SearchRules.Sorts {
+		_, err := castMembers.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: sort})
+		assert.NoError(t, err, "cast member sort %q", sort)
+	}
+}
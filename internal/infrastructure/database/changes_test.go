@@ -0,0 +1,137 @@
+package database_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/cdc"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+)
+
+func TestGivenCastMemberWrites_WhenReadingTheChangeFeed_ThenShouldReturnAnEnvelopeForEach(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCastMemberGateway(db)
+	c, err := castmember.NewCastMember(nil, "Vin Diesel", castmember.Actor)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, c.Update(nil, "Greta Gerwig", castmember.Director))
+	_, err = gateway.Update(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+	require.NoError(t, gateway.DeleteByID(t.Context(), c.ID), "deleting a missing row changes nothing")
+
+	changes, last, err := database.NewChangeFeed(db).Since(t.Context(), 0, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), last)
+	require.Len(t, changes, 3)
+	assert.Equal(t, []cdc.Op{cdc.OpCreate, cdc.OpUpdate, cdc.OpDelete},
+		[]cdc.Op{changes[0].Payload.Op, changes[1].Payload.Op, changes[2].Payload.Op})
+	assert.Equal(t, uint64(1), changes[0].Offset)
+	assert.Equal(t, "cast_members", changes[0].Payload.Source.Table)
+	var before, after cdc.CastMemberRow
+	require.NoError(t, json.Unmarshal(changes[1].Payload.Before, &before))
+	require.NoError(t, json.Unmarshal(changes[1].Payload.After, &after))
+	assert.Equal(t, "Vin Diesel", before.Name)
+	assert.Equal(t, cdc.NewCastMemberRow(c), after)
+	assert.JSONEq(t, "null", string(changes[2].Payload.After))
+}
+
+func TestGivenAFailedWrite_WhenReadingTheChangeFeed_ThenShouldHoldNoChangeForIt(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), c)
+	require.ErrorIs(t, err, category.ErrCategoryAlreadyExists)
+	_, err = gateway.Update(t.Context(), newCategory(t, "Series", "", true))
+	require.ErrorIs(t, err, category.ErrCategoryNotFound)
+
+	_, last, err := database.NewChangeFeed(db).Since(t.Context(), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), last)
+}
+
+func TestGivenStoredChanges_WhenTheFeedIsReopened_ThenShouldKeepItsOffsets(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	for _, name := range []string{"Filmes", "Series"} {
+		_, err := gateway.Create(t.Context(), newCategory(t, name, "", true))
+		require.NoError(t, err)
+	}
+
+	_, err := database.NewCategoryGateway(db).Create(t.Context(), newCategory(t, "Anime", "", true))
+	require.NoError(t, err)
+	reopened := database.NewChangeFeed(db)
+
+	changes, last, err := reopened.Since(t.Context(), 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), last)
+	require.Len(t, changes, 1)
+	assert.Equal(t, uint64(3), changes[0].Offset)
+	_, last, err = reopened.Since(t.Context(), 4, 10)
+	assert.ErrorIs(t, err, cdc.ErrOffsetAhead)
+	assert.Equal(t, uint64(3), last)
+}
+
+func TestGivenConcurrentUpdates_WhenCallUpdate_ThenShouldCaptureEveryChangeInOrder(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	categories := make([]*category.Category, 4)
+	for i := range categories {
+		categories[i] = newCategory(t, "Filmes", "", true)
+		_, err := gateway.Create(t.Context(), categories[i])
+		require.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range categories {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				_, err := gateway.Update(t.Context(), c)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	changes, last, err := database.NewChangeFeed(db).Since(t.Context(), 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(44), last)
+	for i, change := range changes {
+		assert.Equal(t, uint64(i+1), change.Offset)
+	}
+}
+
+func TestGivenConcurrentCreates_WhenCallCreate_ThenShouldGiveEachChangeItsOwnOffset(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+
+	var wg sync.WaitGroup
+	for range 20 {
+		c := newCategory(t, "Filmes", "", true)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := gateway.Create(t.Context(), c)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	changes, last, err := database.NewChangeFeed(db).Since(t.Context(), 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), last)
+	assert.Len(t, changes, 20)
+}
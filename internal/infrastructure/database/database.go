@@ -0,0 +1,24 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Open connects to the database behind driver and dsn and brings its schema
+// up to date. The caller is responsible for importing the driver.
+func Open(driver, dsn string) (*sql.DB, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect to database: %w", err)
+	}
+	if err := Migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
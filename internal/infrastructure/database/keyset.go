@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/validation"
+)
+
+// table describes how to read one aggregate from its table.
+type table[T any] struct {
+	name    string
+	columns string
+	scan    func(row rowScanner) (*T, error)
+	id      func(entity *T) string
+}
+
+// findByCursor seeks past the query's cursor with a keyset condition instead
+// of an OFFSET, so pages stay stable while rows are inserted concurrently.
+func findByCursor[T any](
+	ctx context.Context,
+	db *sql.DB,
+	t table[T],
+	where whereClause,
+	sorting sortKey[T],
+	desc bool,
+	query pagination.CursorQuery,
+) (*pagination.CursorPage[T], error) {
+	cursor, backward := query.Position()
+	if cursor != nil {
+		if err := addKeyset(&where, sorting, cursor, desc, backward); err != nil {
+			return nil, err
+		}
+	}
+
+	conditions := where.String()
+	limit := where.arg(query.Size + 1)
+	rows, err := db.QueryContext(
+		ctx,
+		`SELECT `+t.columns+` FROM `+t.name+conditions+` `+orderBy(sorting.exprs(), desc, backward)+` LIMIT `+limit,
+		where.args...,
+	)
+	if err != nil {
+		return nil, queryError(ctx, "list "+t.name, err)
+	}
+	defer rows.Close()
+
+	fetched := make([]T, 0, query.Size+1)
+	for rows.Next() {
+		entity, err := t.scan(rows)
+		if err != nil {
+			return nil, queryError(ctx, "list "+t.name, err)
+		}
+		fetched = append(fetched, *entity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, "list "+t.name, err)
+	}
+
+	return pagination.NewCursorPage(query, fetched, func(entity *T) ([]string, string) {
+		return sorting.keys(entity), t.id(entity)
+	}), nil
+}
+
+// addKeyset restricts rows to those strictly past cursor in traversal order:
+// (c1 > k1) OR (c1 = k1 AND c2 > k2) OR ... OR (all equal AND id > cursor id).
+func addKeyset[T any](where *whereClause, sorting sortKey[T], cursor *pagination.Cursor, desc, backward bool) error {
+	if len(cursor.Keys) != len(sorting) {
+		return validation.NewNotification().
+			Append("cursor", validation.CodeInvalid, "'cursor' does not match the listing's sort keys")
+	}
+
+	keyOp, idOp := ">", ">"
+	if desc != backward {
+		keyOp = "<"
+	}
+	if backward {
+		idOp = "<"
+	}
+
+	var (
+		alternatives []string
+		equalities   []string
+	)
+	for i, column := range sorting {
+		arg, err := column.arg(cursor.Keys[i])
+		if err != nil {
+			return validation.NewNotification().
+				Append("cursor", validation.CodeInvalid, "'cursor' does not match the listing's sort keys")
+		}
+		placeholder := where.arg(arg)
+		alternatives = append(alternatives, "("+strings.Join(append(slices.Clip(equalities), column.expr+" "+keyOp+" "+placeholder), " AND ")+")")
+		equalities = append(equalities, column.expr+" = "+placeholder)
+	}
+	alternatives = append(alternatives, "("+strings.Join(append(slices.Clip(equalities), "id "+idOp+" "+where.arg(cursor.ID)), " AND ")+")")
+
+	where.add("(" + strings.Join(alternatives, " OR ") + ")")
+	return nil
+}
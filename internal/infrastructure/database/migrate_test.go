@@ -0,0 +1,22 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+)
+
+func TestGivenAMigratedDatabase_WhenCallMigrateAgain_ThenShouldBeANoOp(t *testing.T) {
+	db := newDB(t)
+	var before int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&before))
+
+	require.NoError(t, database.Migrate(db))
+
+	var after int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&after))
+	assert.Equal(t, before, after)
+	assert.Positive(t, after)
+}
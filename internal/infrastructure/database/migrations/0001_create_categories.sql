@@ -0,0 +1,11 @@
+CREATE TABLE categories (
+    id          VARCHAR(36)  NOT NULL PRIMARY KEY,
+    name        VARCHAR(255) NOT NULL,
+    description TEXT         NOT NULL DEFAULT '',
+    is_active   BOOLEAN      NOT NULL,
+    created_at  TIMESTAMP    NOT NULL,
+    updated_at  TIMESTAMP    NOT NULL,
+    deleted_at  TIMESTAMP    NULL
+);
+
+CREATE INDEX idx_categories_name ON categories (name);
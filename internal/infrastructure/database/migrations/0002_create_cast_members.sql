@@ -0,0 +1,9 @@
+CREATE TABLE cast_members (
+    id         VARCHAR(36)  NOT NULL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    type       VARCHAR(16)  NOT NULL CHECK (type IN ('ACTOR', 'DIRECTOR')),
+    created_at TIMESTAMP    NOT NULL,
+    updated_at TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX idx_cast_members_name ON cast_members (name);
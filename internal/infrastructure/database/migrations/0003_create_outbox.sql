@@ -0,0 +1,15 @@
+CREATE TABLE outbox (
+    id           VARCHAR(36)  NOT NULL PRIMARY KEY,
+    event_name   VARCHAR(255) NOT NULL,
+    aggregate_id VARCHAR(36)  NOT NULL,
+    payload      TEXT         NOT NULL,
+    occurred_at  TIMESTAMP    NOT NULL,
+    recorded_at  TIMESTAMP    NOT NULL,
+    seq          INTEGER      NOT NULL,
+    attempts     INTEGER      NOT NULL DEFAULT 0,
+    last_error   TEXT         NULL,
+    sent_at      TIMESTAMP    NULL,
+    dead_at      TIMESTAMP    NULL
+);
+
+CREATE INDEX idx_outbox_pending ON outbox (sent_at, dead_at, recorded_at, seq);
@@ -0,0 +1,11 @@
+-- name_key holds pagination.TextKey(name), written by the gateways, so that
+-- sorting and keyset cursors compare exactly the keys the application built.
+-- LOWER only folds ASCII; rows with other letters get their exact key the
+-- next time they are written.
+ALTER TABLE categories ADD COLUMN name_key VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE categories SET name_key = LOWER(name);
+CREATE INDEX idx_categories_name_key ON categories (name_key);
+
+ALTER TABLE cast_members ADD COLUMN name_key VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE cast_members SET name_key = LOWER(name);
+CREATE INDEX idx_cast_members_name_key ON cast_members (name_key);
@@ -0,0 +1,7 @@
+-- changes is the change stream of the SQL gateways: each row is a cdc
+-- envelope written in the same transaction as the row it describes, so its
+-- offset survives restarts and no committed write goes missing from it.
+CREATE TABLE changes (
+    change_offset BIGINT NOT NULL PRIMARY KEY,
+    payload       TEXT   NOT NULL
+);
@@ -0,0 +1,25 @@
+-- position is handed out by the database as rows are inserted, so it follows
+-- the order events were written whatever the clocks said; recorded_at and the
+-- per-transaction seq could tie or run backwards. The relay orders by it alone.
+CREATE TABLE outbox_by_position (
+    position     INTEGER      NOT NULL PRIMARY KEY AUTOINCREMENT,
+    id           VARCHAR(36)  NOT NULL UNIQUE,
+    event_name   VARCHAR(255) NOT NULL,
+    aggregate_id VARCHAR(36)  NOT NULL,
+    payload      TEXT         NOT NULL,
+    occurred_at  TIMESTAMP    NOT NULL,
+    recorded_at  TIMESTAMP    NOT NULL,
+    attempts     INTEGER      NOT NULL DEFAULT 0,
+    last_error   TEXT         NULL,
+    sent_at      TIMESTAMP    NULL,
+    dead_at      TIMESTAMP    NULL
+);
+
+INSERT INTO outbox_by_position (id, event_name, aggregate_id, payload, occurred_at, recorded_at, attempts, last_error, sent_at, dead_at)
+SELECT id, event_name, aggregate_id, payload, occurred_at, recorded_at, attempts, last_error, sent_at, dead_at
+FROM outbox
+ORDER BY recorded_at, seq, id;
+
+DROP TABLE outbox;
+ALTER TABLE outbox_by_position RENAME TO outbox;
+CREATE INDEX idx_outbox_pending ON outbox (sent_at, dead_at, position);
@@ -0,0 +1,13 @@
+-- change_offset is handed out by the database on insert instead of being
+-- read back as MAX + 1, which concurrent writers that do not serialize on
+-- the table (PostgreSQL at READ COMMITTED) would both read the same.
+CREATE TABLE changes_by_identity (
+    change_offset INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    payload       TEXT    NOT NULL
+);
+
+INSERT INTO changes_by_identity (change_offset, payload)
+SELECT change_offset, payload FROM changes ORDER BY change_offset;
+
+DROP TABLE changes;
+ALTER TABLE changes_by_identity RENAME TO changes;
@@ -0,0 +1,6 @@
+-- description_key holds pagination.TextKey(description), written by the
+-- gateways, so that searches fold descriptions the way the application folds
+-- terms. LOWER only folds ASCII; rows with other letters get their exact key
+-- the next time they are written.
+ALTER TABLE categories ADD COLUMN description_key TEXT NOT NULL DEFAULT '';
+UPDATE categories SET description_key = LOWER(description);
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+// outboxDecoders turns a stored payload back into the event it was written
+// from, keyed by event name. Events missing here cannot be relayed.
+var outboxDecoders = map[string]func(payload []byte) (event.Event, error){
+	category.CategoryCreatedEvent:     decodeEvent[category.CategoryCreated],
+	category.CategoryUpdatedEvent:     decodeEvent[category.CategoryUpdated],
+	category.CategoryActivatedEvent:   decodeEvent[category.CategoryActivated],
+	category.CategoryDeactivatedEvent: decodeEvent[category.CategoryDeactivated],
+	castmember.CastMemberCreatedEvent: decodeEvent[castmember.CastMemberCreated],
+	castmember.CastMemberUpdatedEvent: decodeEvent[castmember.CastMemberUpdated],
+	castmember.CastMemberDeletedEvent: decodeEvent[castmember.CastMemberDeleted],
+}
+
+func decodeEvent[E event.Event](payload []byte) (event.Event, error) {
+	var e E
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// inTx runs write in a transaction that commits only if write succeeds.
+func inTx(ctx context.Context, db *sql.DB, write func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := write(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// writeOutbox stores events in tx, so they are committed or rolled back
+// together with the change that raised them. clock stamps recorded_at; the
+// database numbers the rows, which is the order the relay publishes them in.
+func writeOutbox(ctx context.Context, tx *sql.Tx, clock timeutils.Clock, events []event.Event) error {
+	recordedAt := timeutils.Now(clock).UTC()
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("encode %s: %w", e.EventName(), err)
+		}
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO outbox (id, event_name, aggregate_id, payload, occurred_at, recorded_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+			uuid.NewString(), e.EventName(), e.AggregateID(), string(payload), e.OccurredAt().UTC(), recordedAt,
+		); err != nil {
+			return fmt.Errorf("write %s to the outbox: %w", e.EventName(), err)
+		}
+	}
+	return nil
+}
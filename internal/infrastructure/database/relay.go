@@ -0,0 +1,248 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+const (
+	defaultRelayBatchSize    = 100
+	defaultRelayPollInterval = time.Second
+	defaultRelayMaxAttempts  = 5
+)
+
+// OutboxPublisher delivers one relayed event. Returning an error leaves the
+// message in the outbox to be tried again on a later poll.
+type OutboxPublisher interface {
+	Publish(ctx context.Context, e event.Event) error
+}
+
+type OutboxPublisherFunc func(ctx context.Context, e event.Event) error
+
+func (f OutboxPublisherFunc) Publish(ctx context.Context, e event.Event) error {
+	return f(ctx, e)
+}
+
+// ForwardTo relays into an event.Publisher such as the in-process event bus,
+// which takes over retrying the delivery to each subscriber.
+func ForwardTo(publisher event.Publisher) OutboxPublisher {
+	return OutboxPublisherFunc(func(ctx context.Context, e event.Event) error {
+		publisher.Publish(ctx, e)
+		return nil
+	})
+}
+
+// PoisonMessage is an outbox message the relay gave up on: its payload could
+// not be decoded, or publishing it failed MaxAttempts times. It stays in the
+// outbox, marked dead, for someone to inspect.
+type PoisonMessage struct {
+	ID          string
+	EventName   string
+	AggregateID string
+	Payload     string
+	Attempts    int
+	Err         error
+}
+
+// outboxMessage is a pending outbox row as the relay works on it.
+type outboxMessage struct {
+	id          string
+	eventName   string
+	aggregateID string
+	payload     string
+	attempts    int
+}
+
+type RelayOption func(r *Relay)
+
+func WithBatchSize(size int) RelayOption {
+	return func(r *Relay) { r.batchSize = size }
+}
+
+func WithPollInterval(interval time.Duration) RelayOption {
+	return func(r *Relay) { r.pollInterval = interval }
+}
+
+// WithMaxAttempts sets how many failed publishes turn a message into poison.
+func WithMaxAttempts(attempts int) RelayOption {
+	return func(r *Relay) { r.maxAttempts = max(attempts, 1) }
+}
+
+// WithClock replaces the system clock that stamps when messages were sent or
+// declared poison.
+func WithClock(clock timeutils.Clock) RelayOption {
+	return func(r *Relay) { r.clock = clock }
+}
+
+// WithPoisonHandler replaces the default, which logs, as the place poison
+// messages are reported.
+func WithPoisonHandler(onPoison func(PoisonMessage)) RelayOption {
+	return func(r *Relay) { r.onPoison = onPoison }
+}
+
+// Relay moves events from the outbox to an OutboxPublisher. Messages are
+// published in the order they were written, at least once: a failed publish
+// stops the batch so nothing overtakes it, until the message succeeds or is
+// declared poison. A single relay per database is assumed.
+type Relay struct {
+	db           *sql.DB
+	publisher    OutboxPublisher
+	batchSize    int
+	pollInterval time.Duration
+	maxAttempts  int
+	onPoison     func(PoisonMessage)
+	clock        timeutils.Clock
+}
+
+func NewRelay(db *sql.DB, publisher OutboxPublisher, options ...RelayOption) *Relay {
+	r := &Relay{
+		db:           db,
+		publisher:    publisher,
+		batchSize:    defaultRelayBatchSize,
+		pollInterval: defaultRelayPollInterval,
+		maxAttempts:  defaultRelayMaxAttempts,
+		onPoison:     logPoison,
+	}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// Run polls the outbox until ctx is done. A full batch is followed by another
+// poll straight away; otherwise the relay waits for the poll interval.
+func (r *Relay) Run(ctx context.Context) {
+	for {
+		relayed, err := r.RelayOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+		if err == nil && relayed == r.batchSize {
+			continue
+		}
+		select {
+		case <-time.After(r.pollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RelayOnce publishes the next batch of pending messages and reports how
+// many of them it handled, whether they were sent or declared poison.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	messages, err := r.pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, m := range messages {
+		decode, ok := outboxDecoders[m.eventName]
+		if !ok {
+			if err := r.bury(ctx, m, fmt.Errorf("no decoder for %s", m.eventName)); err != nil {
+				return i, err
+			}
+			continue
+		}
+		e, err := decode([]byte(m.payload))
+		if err != nil {
+			if err := r.bury(ctx, m, fmt.Errorf("decode %s: %w", m.eventName, err)); err != nil {
+				return i, err
+			}
+			continue
+		}
+
+		m.attempts++
+		publishErr := r.publisher.Publish(ctx, e)
+		switch {
+		case publishErr == nil:
+			if err := r.markSent(ctx, m); err != nil {
+				return i, err
+			}
+		case ctx.Err() != nil:
+			return i, ctx.Err()
+		case m.attempts >= r.maxAttempts:
+			if err := r.bury(ctx, m, publishErr); err != nil {
+				return i, err
+			}
+		default:
+			return i, r.markFailed(ctx, m, publishErr)
+		}
+	}
+	return len(messages), nil
+}
+
+func (r *Relay) pending(ctx context.Context) ([]outboxMessage, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, event_name, aggregate_id, payload, attempts FROM outbox WHERE sent_at IS NULL AND dead_at IS NULL ORDER BY position LIMIT $1`,
+		r.batchSize,
+	)
+	if err != nil {
+		return nil, queryError(ctx, "read outbox", err)
+	}
+	defer rows.Close()
+
+	var messages []outboxMessage
+	for rows.Next() {
+		var m outboxMessage
+		if err := rows.Scan(&m.id, &m.eventName, &m.aggregateID, &m.payload, &m.attempts); err != nil {
+			return nil, queryError(ctx, "read outbox", err)
+		}
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, queryError(ctx, "read outbox", err)
+	}
+	return messages, nil
+}
+
+func (r *Relay) markSent(ctx context.Context, m outboxMessage) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox SET sent_at = $1, attempts = $2 WHERE id = $3`, timeutils.Now(r.clock).UTC(), m.attempts, m.id)
+	if err != nil {
+		return queryError(ctx, "mark outbox message sent", err)
+	}
+	return nil
+}
+
+func (r *Relay) markFailed(ctx context.Context, m outboxMessage, cause error) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE outbox SET attempts = $1, last_error = $2 WHERE id = $3`, m.attempts, cause.Error(), m.id)
+	if err != nil {
+		return queryError(ctx, "record outbox failure", err)
+	}
+	return nil
+}
+
+func (r *Relay) bury(ctx context.Context, m outboxMessage, cause error) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`UPDATE outbox SET dead_at = $1, attempts = $2, last_error = $3 WHERE id = $4`,
+		timeutils.Now(r.clock).UTC(), m.attempts, cause.Error(), m.id,
+	)
+	if err != nil {
+		return queryError(ctx, "mark outbox message dead", err)
+	}
+	r.onPoison(PoisonMessage{
+		ID:          m.id,
+		EventName:   m.eventName,
+		AggregateID: m.aggregateID,
+		Payload:     m.payload,
+		Attempts:    m.attempts,
+		Err:         cause,
+	})
+	return nil
+}
+
+func logPoison(m PoisonMessage) {
+	log.Printf("outbox relay: gave up on %s %s (message %s) after %d attempt(s): %v",
+		m.EventName, m.AggregateID, m.ID, m.Attempts, m.Err)
+}
@@ -0,0 +1,220 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/database"
+	eventtest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/event-test"
+	timeutils "github.com/williamsbgomes/admin-catalogo-video-go/pkg/time-utils"
+)
+
+func TestGivenANewCategory_WhenCallCreate_ThenShouldWriteItsEventsToTheOutbox(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	var name, aggregateID string
+	require.NoError(t, db.QueryRow(`SELECT event_name, aggregate_id FROM outbox`).Scan(&name, &aggregateID))
+	assert.Equal(t, category.CategoryCreatedEvent, name)
+	assert.Equal(t, c.ID.String(), aggregateID)
+}
+
+func TestGivenACreateThatFails_WhenCallCreate_ThenShouldNotWriteToTheOutbox(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), c)
+
+	require.Error(t, err)
+	var total int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM outbox`).Scan(&total))
+	assert.Equal(t, 1, total)
+}
+
+func TestGivenADeletedCastMember_WhenCallDeleteByID_ThenShouldWriteItsCastMemberDeleted(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCastMemberGateway(db)
+	c, err := castmember.NewCastMember(nil, "Keanu", castmember.Actor)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	c.PullEvents()
+	deletedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	c.Delete(timeutils.NewFakeClock(deletedAt))
+	require.NoError(t, gateway.DeleteByID(t.Context(), c.ID, c.PendingEvents()...))
+
+	recorder := eventtest.NewRecorder()
+	_, err = database.NewRelay(db, database.ForwardTo(recorder)).RelayOnce(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{castmember.CastMemberCreatedEvent, castmember.CastMemberDeletedEvent}, recorder.Names())
+	deleted := eventtest.Of[castmember.CastMemberDeleted](recorder)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, c.ID, deleted[0].CastMemberID)
+	assert.True(t, deletedAt.Equal(deleted[0].At), "the event is the aggregate's, stamped by its clock")
+}
+
+func TestGivenPendingMessages_WhenCallRelayOnce_ThenShouldPublishInOrderAndMarkThemSent(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	c.PullEvents()
+	require.NoError(t, c.Update(nil, "Séries", "", false))
+	_, err = gateway.Update(t.Context(), c)
+	require.NoError(t, err)
+	recorder := eventtest.NewRecorder()
+	relay := database.NewRelay(db, database.ForwardTo(recorder))
+
+	relayed, err := relay.RelayOnce(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, relayed)
+	assert.Equal(t, []string{
+		category.CategoryCreatedEvent,
+		category.CategoryDeactivatedEvent,
+		category.CategoryUpdatedEvent,
+	}, recorder.Names())
+	updated := eventtest.Of[category.CategoryUpdated](recorder)
+	require.Len(t, updated, 1)
+	assert.Equal(t, "Séries", updated[0].Name)
+
+	relayed, err = relay.RelayOnce(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, relayed)
+	assert.Len(t, recorder.Events(), 3)
+}
+
+func TestGivenAClockThatStepsBack_WhenCallRelayOnce_ThenShouldStillPublishInWriteOrder(t *testing.T) {
+	db := newDB(t)
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = database.NewCategoryGateway(db).WithClock(timeutils.NewFakeClock(createdAt)).Create(t.Context(), c)
+	require.NoError(t, err)
+	c.PullEvents()
+
+	for i, updatedAt := range []time.Time{createdAt, createdAt.Add(-time.Minute)} {
+		require.NoError(t, c.Update(nil, fmt.Sprintf("Filmes %d", i), "", true))
+		_, err = database.NewCategoryGateway(db).WithClock(timeutils.NewFakeClock(updatedAt)).Update(t.Context(), c)
+		require.NoError(t, err)
+		c.PullEvents()
+	}
+	recorder := eventtest.NewRecorder()
+	_, err = database.NewRelay(db, database.ForwardTo(recorder)).RelayOnce(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		category.CategoryCreatedEvent,
+		category.CategoryUpdatedEvent,
+		category.CategoryUpdatedEvent,
+	}, recorder.Names())
+}
+
+func TestGivenClocks_WhenWritingAndRelayingAMessage_ThenShouldStampItWithThem(t *testing.T) {
+	db := newDB(t)
+	recordedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	sentAt := recordedAt.Add(time.Minute)
+	gateway := database.NewCategoryGateway(db).WithClock(timeutils.NewFakeClock(recordedAt))
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+
+	_, err = database.NewRelay(db, database.ForwardTo(eventtest.NewRecorder()), database.WithClock(timeutils.NewFakeClock(sentAt))).
+		RelayOnce(t.Context())
+
+	require.NoError(t, err)
+	var storedRecordedAt, storedSentAt time.Time
+	require.NoError(t, db.QueryRow(`SELECT recorded_at, sent_at FROM outbox`).Scan(&storedRecordedAt, &storedSentAt))
+	assert.True(t, recordedAt.Equal(storedRecordedAt), storedRecordedAt)
+	assert.True(t, sentAt.Equal(storedSentAt), storedSentAt)
+}
+
+func TestGivenAFailingPublisher_WhenCallRelayOnce_ThenShouldRetryAndThenDeclareThePoison(t *testing.T) {
+	db := newDB(t)
+	gateway := database.NewCategoryGateway(db)
+	first, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), first)
+	require.NoError(t, err)
+	second, err := category.NewCategory(nil, "Séries", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), second)
+	require.NoError(t, err)
+
+	var published []string
+	publisher := database.OutboxPublisherFunc(func(_ context.Context, e event.Event) error {
+		if e.AggregateID() == first.ID.String() {
+			return errors.New("broker unavailable")
+		}
+		published = append(published, e.AggregateID())
+		return nil
+	})
+	var poisoned []database.PoisonMessage
+	relay := database.NewRelay(db, publisher,
+		database.WithMaxAttempts(2),
+		database.WithPoisonHandler(func(m database.PoisonMessage) { poisoned = append(poisoned, m) }),
+	)
+
+	relayed, err := relay.RelayOnce(t.Context())
+	require.NoError(t, err)
+	assert.Zero(t, relayed)
+	assert.Empty(t, published, "a failed message should hold back the ones after it")
+	assert.Empty(t, poisoned)
+
+	relayed, err = relay.RelayOnce(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2, relayed)
+	assert.Equal(t, []string{second.ID.String()}, published)
+	require.Len(t, poisoned, 1)
+	assert.Equal(t, first.ID.String(), poisoned[0].AggregateID)
+	assert.Equal(t, 2, poisoned[0].Attempts)
+	assert.EqualError(t, poisoned[0].Err, "broker unavailable")
+
+	var lastError string
+	require.NoError(t, db.QueryRow(`SELECT last_error FROM outbox WHERE dead_at IS NOT NULL`).Scan(&lastError))
+	assert.Equal(t, "broker unavailable", lastError)
+}
+
+func TestGivenAnUndecodableMessage_WhenCallRelayOnce_ThenShouldDeclareItPoisonRightAway(t *testing.T) {
+	db := newDB(t)
+	_, err := db.Exec(
+		`INSERT INTO outbox (id, event_name, aggregate_id, payload, occurred_at, recorded_at) VALUES ('1', $1, 'abc', '{', '2024-01-01 00:00:00', '2024-01-01 00:00:00')`,
+		category.CategoryCreatedEvent,
+	)
+	require.NoError(t, err)
+	recorder := eventtest.NewRecorder()
+	var poisoned []database.PoisonMessage
+	relay := database.NewRelay(db, database.ForwardTo(recorder),
+		database.WithPoisonHandler(func(m database.PoisonMessage) { poisoned = append(poisoned, m) }),
+	)
+
+	relayed, err := relay.RelayOnce(t.Context())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, relayed)
+	assert.Empty(t, recorder.Events())
+	require.Len(t, poisoned, 1)
+	assert.Equal(t, "1", poisoned[0].ID)
+	assert.Zero(t, poisoned[0].Attempts)
+}
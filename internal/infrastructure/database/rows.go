@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+)
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func utcOrNil(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+// saved is the copy of entity a gateway returns once it has been written.
+// The events still pending on entity stay with the caller.
+func saved[T any, P interface {
+	*T
+	PullEvents() []event.Event
+}](entity P) *T {
+	copied := *entity
+	P(&copied).PullEvents()
+	return &copied
+}
+
+// claimRow takes the write lock on the row of table with id before the
+// transaction reads it, and reports whether the row exists. Starting with a
+// write matters on SQLite, which fails one of two transactions that both read
+// before they write.
+func claimRow(ctx context.Context, tx *sql.Tx, table string, id any) (bool, error) {
+	result, err := tx.ExecContext(ctx, `UPDATE `+table+` SET id = id WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// queryError wraps a failed statement. Drivers report a statement interrupted
+// by its context in their own terms, so ctx's error takes precedence to keep
+// cancellations and deadlines recognisable with errors.Is.
+func queryError(ctx context.Context, op string, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return fmt.Errorf("%s: %w", op, ctxErr)
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
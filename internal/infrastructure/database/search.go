@@ -0,0 +1,64 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// whereClause collects AND-ed conditions and their arguments, numbering
+// placeholders in order of appearance ($1, $2, ...).
+type whereClause struct {
+	conditions []string
+	args       []any
+}
+
+func (w *whereClause) arg(value any) string {
+	w.args = append(w.args, value)
+	return "$" + strconv.Itoa(len(w.args))
+}
+
+func (w *whereClause) add(condition string) {
+	w.conditions = append(w.conditions, condition)
+}
+
+func (w *whereClause) String() string {
+	if len(w.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(w.conditions, " AND ")
+}
+
+// likePattern turns free-text terms into a LIKE pattern, folded with
+// pagination.TextKey to compare against the *_key columns, that matches them
+// as a literal substring; used together with ESCAPE '\'.
+// It returns "" when there is nothing to search for.
+func likePattern(terms string) string {
+	terms = pagination.TextKey(strings.TrimSpace(terms))
+	if terms == "" {
+		return ""
+	}
+	return "%" + strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(terms) + "%"
+}
+
+// orderBy builds an ORDER BY clause from whitelisted column expressions, so
+// SearchQuery.Sort never reaches the SQL text directly. Ties break on id to
+// keep paging stable; reverse flips every column for backward traversal.
+func orderBy(columns []string, desc, reverse bool) string {
+	direction, idDirection := " ASC", " ASC"
+	if desc != reverse {
+		direction = " DESC"
+	}
+	if reverse {
+		idDirection = " DESC"
+	}
+	return "ORDER BY " + strings.Join(columns, direction+", ") + direction + ", id" + idDirection
+}
+
+// pageBounds reports whether the query can return any rows out of total. It
+// compares the page with the last one rather than multiplying, so a huge page
+// cannot wrap the OFFSET negative.
+func pageBounds(query pagination.SearchQuery, total int64) bool {
+	return query.Page >= 0 && query.PerPage > 0 && total > 0 && int64(query.Page) <= (total-1)/int64(query.PerPage)
+}
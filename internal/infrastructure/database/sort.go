@@ -0,0 +1,60 @@
+package database
+
+import (
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// sortColumn is one whitelisted ORDER BY expression together with how to
+// read its cursor key from an entity and bind that key back as an argument.
+type sortColumn[T any] struct {
+	expr string
+	key  func(entity *T) string
+	arg  func(key string) (any, error)
+}
+
+type sortKey[T any] []sortColumn[T]
+
+// textColumn sorts on keyColumn, which must hold pagination.TextKey of the
+// text as written by the gateway. Folding case in SQL instead would disagree
+// with the cursor keys on anything but ASCII.
+func textColumn[T any](keyColumn string, value func(entity *T) string) sortColumn[T] {
+	return sortColumn[T]{
+		expr: keyColumn,
+		key:  func(entity *T) string { return pagination.TextKey(value(entity)) },
+		arg:  func(key string) (any, error) { return key, nil },
+	}
+}
+
+func enumColumn[T any](column string, value func(entity *T) string) sortColumn[T] {
+	return sortColumn[T]{
+		expr: column,
+		key:  value,
+		arg:  func(key string) (any, error) { return key, nil },
+	}
+}
+
+func timeColumn[T any](column string, value func(entity *T) time.Time) sortColumn[T] {
+	return sortColumn[T]{
+		expr: column,
+		key:  func(entity *T) string { return pagination.TimeKey(value(entity)) },
+		arg:  func(key string) (any, error) { return pagination.ParseTimeKey(key) },
+	}
+}
+
+func (s sortKey[T]) exprs() []string {
+	exprs := make([]string, len(s))
+	for i, column := range s {
+		exprs[i] = column.expr
+	}
+	return exprs
+}
+
+func (s sortKey[T]) keys(entity *T) []string {
+	keys := make([]string, len(s))
+	for i, column := range s {
+		keys[i] = column.key(entity)
+	}
+	return keys
+}
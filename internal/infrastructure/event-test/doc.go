@@ -0,0 +1,3 @@
+// Package eventtest captures published domain events so tests can assert on
+// what a use case announced, without wiring up a real event bus.
+package eventtest
@@ -0,0 +1,61 @@
+package eventtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+)
+
+// Recorder is an event.Publisher that keeps everything published to it.
+// It is safe for concurrent use.
+type Recorder struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+var _ event.Publisher = (*Recorder)(nil)
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Publish(_ context.Context, events ...event.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, events...)
+}
+
+// Events returns what has been published so far, in order.
+func (r *Recorder) Events() []event.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]event.Event(nil), r.events...)
+}
+
+// Names returns the EventName of everything published so far, in order.
+func (r *Recorder) Names() []string {
+	events := r.Events()
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = e.EventName()
+	}
+	return names
+}
+
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
+
+// Of returns the published events of type E, in order.
+func Of[E event.Event](r *Recorder) []E {
+	var matches []E
+	for _, e := range r.Events() {
+		if match, ok := e.(E); ok {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
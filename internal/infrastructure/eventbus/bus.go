@@ -0,0 +1,266 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+)
+
+// ErrClosed is reported for events published after Close.
+var ErrClosed = errors.New("event bus is closed")
+
+const defaultQueueSize = 64
+
+// Handler reacts to one event of type E. Returning an error, or panicking,
+// makes the bus retry the delivery as the subscription allows.
+type Handler[E event.Event] func(ctx context.Context, e E) error
+
+type Mode int
+
+const (
+	// Sync delivers inside Publish, which returns once the subscriber has
+	// succeeded or given up.
+	Sync Mode = iota
+	// Async queues the event for the subscriber's own goroutine, which
+	// delivers in publish order. Publish only waits for room in the queue.
+	Async
+)
+
+// Failure describes a delivery the bus gave up on.
+type Failure struct {
+	Subscriber string
+	Event      event.Event
+	Attempts   int
+	Err        error
+}
+
+// PanicError is the error a delivery fails with when its handler panics.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("subscriber panicked: %v", e.Value)
+}
+
+type Option func(b *Bus)
+
+// WithFailureHandler replaces the default, which logs, as the place failed
+// deliveries are reported.
+func WithFailureHandler(onFailure func(Failure)) Option {
+	return func(b *Bus) { b.onFailure = onFailure }
+}
+
+// WithQueueSize sets how many events an Async subscriber may have waiting
+// before Publish blocks.
+func WithQueueSize(size int) Option {
+	return func(b *Bus) { b.queueSize = size }
+}
+
+type SubscribeOption func(s *subscription)
+
+// WithName names the subscriber in failure reports.
+func WithName(name string) SubscribeOption {
+	return func(s *subscription) { s.name = name }
+}
+
+func WithMode(mode Mode) SubscribeOption {
+	return func(s *subscription) { s.mode = mode }
+}
+
+// WithRetry allows up to attempts deliveries of each event, waiting backoff
+// before the second and doubling the wait before every later one.
+func WithRetry(attempts int, backoff time.Duration) SubscribeOption {
+	return func(s *subscription) {
+		s.attempts = max(attempts, 1)
+		s.backoff = backoff
+	}
+}
+
+type subscription struct {
+	name     string
+	mode     Mode
+	attempts int
+	backoff  time.Duration
+	handle   func(ctx context.Context, e event.Event) error
+	queue    chan delivery
+}
+
+type delivery struct {
+	ctx   context.Context
+	event event.Event
+}
+
+// Bus is an in-process event.Publisher that routes each event to the
+// subscribers of its Go type. Every subscriber is isolated from the others:
+// one that fails or panics is retried and reported on its own, and neither
+// the publisher nor the other subscribers notice.
+type Bus struct {
+	queueSize int
+	onFailure func(Failure)
+
+	mu            sync.RWMutex
+	subscriptions map[reflect.Type][]*subscription
+	closed        bool
+	workers       sync.WaitGroup
+}
+
+var _ event.Publisher = (*Bus)(nil)
+
+func New(options ...Option) *Bus {
+	b := &Bus{
+		queueSize:     defaultQueueSize,
+		onFailure:     logFailure,
+		subscriptions: make(map[reflect.Type][]*subscription),
+	}
+	for _, option := range options {
+		option(b)
+	}
+	return b
+}
+
+// Subscribe registers handler for events of type E. By default it is Sync
+// and gets a single attempt.
+func Subscribe[E event.Event](b *Bus, handler Handler[E], options ...SubscribeOption) {
+	key := reflect.TypeFor[E]()
+	s := &subscription{
+		mode:     Sync,
+		attempts: 1,
+		handle: func(ctx context.Context, e event.Event) error {
+			return handler(ctx, e.(E))
+		},
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if s.name == "" {
+		s.name = fmt.Sprintf("%s subscriber #%d", key, len(b.subscriptions[key])+1)
+	}
+	if s.mode == Async && !b.closed {
+		s.queue = make(chan delivery, b.queueSize)
+		b.workers.Add(1)
+		go b.work(s)
+	}
+	b.subscriptions[key] = append(b.subscriptions[key], s)
+}
+
+// Publish delivers each event to its subscribers in order. Async deliveries
+// outlive ctx's cancellation but keep its values.
+func (b *Bus) Publish(ctx context.Context, events ...event.Event) {
+	for _, e := range events {
+		b.mu.RLock()
+		subscriptions := b.subscriptions[reflect.TypeOf(e)]
+		b.mu.RUnlock()
+
+		for _, s := range subscriptions {
+			if s.mode == Async {
+				b.enqueue(ctx, s, e)
+			} else {
+				b.deliver(ctx, s, e)
+			}
+		}
+	}
+}
+
+// Close stops accepting events and waits, until ctx is done, for the Async
+// subscribers to work through what is already queued.
+func (b *Bus) Close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, subscriptions := range b.subscriptions {
+			for _, s := range subscriptions {
+				if s.queue != nil {
+					close(s.queue)
+				}
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		b.workers.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Bus) enqueue(ctx context.Context, s *subscription, e event.Event) {
+	// The read lock keeps Close from closing the queue mid-send.
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		b.onFailure(Failure{Subscriber: s.name, Event: e, Err: ErrClosed})
+		return
+	}
+	select {
+	case s.queue <- delivery{ctx: context.WithoutCancel(ctx), event: e}:
+	case <-ctx.Done():
+		b.onFailure(Failure{Subscriber: s.name, Event: e, Err: ctx.Err()})
+	}
+}
+
+func (b *Bus) work(s *subscription) {
+	defer b.workers.Done()
+	for d := range s.queue {
+		b.deliver(d.ctx, s, d.event)
+	}
+}
+
+func (b *Bus) deliver(ctx context.Context, s *subscription, e event.Event) {
+	var err error
+	for attempt := 1; attempt <= s.attempts; attempt++ {
+		if attempt > 1 {
+			if waitErr := wait(ctx, s.backoff<<(attempt-2)); waitErr != nil {
+				b.onFailure(Failure{Subscriber: s.name, Event: e, Attempts: attempt - 1, Err: errors.Join(err, waitErr)})
+				return
+			}
+		}
+		if err = call(ctx, s, e); err == nil {
+			return
+		}
+	}
+	b.onFailure(Failure{Subscriber: s.name, Event: e, Attempts: s.attempts, Err: err})
+}
+
+func call(ctx context.Context, s *subscription, e event.Event) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = PanicError{Value: value, Stack: debug.Stack()}
+		}
+	}()
+	return s.handle(ctx, e)
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func logFailure(f Failure) {
+	log.Printf("eventbus: %s gave up on %s for %s after %d attempt(s): %v",
+		f.Subscriber, f.Event.EventName(), f.Event.AggregateID(), f.Attempts, f.Err)
+}
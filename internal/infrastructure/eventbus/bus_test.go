@@ -0,0 +1,190 @@
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/eventbus"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+// failures collects what the bus reports through WithFailureHandler.
+type failures struct {
+	mu  sync.Mutex
+	all []eventbus.Failure
+}
+
+func (f *failures) record(failure eventbus.Failure) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.all = append(f.all, failure)
+}
+
+func (f *failures) list() []eventbus.Failure {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventbus.Failure(nil), f.all...)
+}
+
+func newBus(t *testing.T) (*eventbus.Bus, *failures) {
+	t.Helper()
+	reported := &failures{}
+	bus := eventbus.New(eventbus.WithFailureHandler(reported.record))
+	t.Cleanup(func() { bus.Close(context.Background()) })
+	return bus, reported
+}
+
+func created(name string) category.CategoryCreated {
+	return category.CategoryCreated{CategoryID: category.NewCategoryID(), Name: name}
+}
+
+func TestGivenSubscribersOfSeveralTypes_WhenCallPublish_ThenShouldRouteByEventType(t *testing.T) {
+	bus, reported := newBus(t)
+	var names []string
+	eventbus.Subscribe(bus, func(_ context.Context, e category.CategoryCreated) error {
+		names = append(names, e.Name)
+		return nil
+	})
+	eventbus.Subscribe(bus, func(_ context.Context, e category.CategoryActivated) error {
+		names = append(names, "activated")
+		return nil
+	})
+
+	bus.Publish(t.Context(), created("Filmes"), category.CategoryDeactivated{}, created("Series"))
+
+	assert.Equal(t, []string{"Filmes", "Series"}, names)
+	assert.Empty(t, reported.list())
+}
+
+func TestGivenAFlakySubscriber_WhenCallPublish_ThenShouldRetryWithBackoffUntilItSucceeds(t *testing.T) {
+	bus, reported := newBus(t)
+	var calls []time.Time
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		calls = append(calls, time.Now())
+		if len(calls) < 3 {
+			return errors.New("downstream unavailable")
+		}
+		return nil
+	}, eventbus.WithRetry(5, 10*time.Millisecond))
+
+	bus.Publish(t.Context(), created("Filmes"))
+
+	require.Len(t, calls, 3)
+	assert.GreaterOrEqual(t, calls[1].Sub(calls[0]), 10*time.Millisecond)
+	assert.GreaterOrEqual(t, calls[2].Sub(calls[1]), 20*time.Millisecond)
+	assert.Empty(t, reported.list())
+}
+
+func TestGivenAFailingSubscriber_WhenRetriesRunOut_ThenShouldReportTheFailure(t *testing.T) {
+	bus, reported := newBus(t)
+	downstreamErr := errors.New("downstream unavailable")
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		return downstreamErr
+	}, eventbus.WithName("search-indexer"), eventbus.WithRetry(3, time.Millisecond))
+	e := created("Filmes")
+
+	bus.Publish(t.Context(), e)
+
+	require.Len(t, reported.list(), 1)
+	failure := reported.list()[0]
+	assert.Equal(t, "search-indexer", failure.Subscriber)
+	assert.Equal(t, e, failure.Event)
+	assert.Equal(t, 3, failure.Attempts)
+	assert.ErrorIs(t, failure.Err, downstreamErr)
+}
+
+func TestGivenACanceledContext_WhenWaitingToRetry_ThenShouldGiveUp(t *testing.T) {
+	bus, reported := newBus(t)
+	ctx, cancel := context.WithCancel(t.Context())
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		cancel()
+		return errors.New("downstream unavailable")
+	}, eventbus.WithRetry(3, time.Hour))
+
+	bus.Publish(ctx, created("Filmes"))
+
+	require.Len(t, reported.list(), 1)
+	assert.Equal(t, 1, reported.list()[0].Attempts)
+	assert.ErrorIs(t, reported.list()[0].Err, context.Canceled)
+}
+
+func TestGivenAPanickingSubscriber_WhenCallPublish_ThenShouldIsolateIt(t *testing.T) {
+	bus, reported := newBus(t)
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		panic("boom")
+	})
+	delivered := 0
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		delivered++
+		return nil
+	})
+
+	assert.NotPanics(t, func() { bus.Publish(t.Context(), created("Filmes")) })
+
+	assert.Equal(t, 1, delivered)
+	require.Len(t, reported.list(), 1)
+	var panicErr eventbus.PanicError
+	require.ErrorAs(t, reported.list()[0].Err, &panicErr)
+	assert.Equal(t, "boom", panicErr.Value)
+}
+
+func TestGivenAPanickingSubscriber_WhenCallCreateCategory_ThenShouldStillSucceed(t *testing.T) {
+	bus, reported := newBus(t)
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		panic("boom")
+	})
+	gateway := memory.NewCategoryGateway()
+	useCase := categoryusecase.NewCreateCategoryUseCase(gateway, bus)
+
+	output, err := useCase.Execute(t.Context(), categoryusecase.CreateCategoryInput{Name: "Filmes", IsActive: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Filmes", output.Name)
+	assert.Len(t, reported.list(), 1)
+}
+
+func TestGivenAnAsyncSubscriber_WhenCallPublish_ThenShouldNotWaitAndDeliverInOrder(t *testing.T) {
+	bus, reported := newBus(t)
+	release := make(chan struct{})
+	var (
+		mu    sync.Mutex
+		names []string
+	)
+	eventbus.Subscribe(bus, func(ctx context.Context, e category.CategoryCreated) error {
+		<-release
+		assert.NoError(t, ctx.Err(), "async deliveries outlive the publisher's context")
+		mu.Lock()
+		defer mu.Unlock()
+		names = append(names, e.Name)
+		return nil
+	}, eventbus.WithMode(eventbus.Async))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	bus.Publish(ctx, created("Filmes"), created("Series"), created("Documentarios"))
+	cancel()
+	close(release)
+	require.NoError(t, bus.Close(t.Context()))
+
+	assert.Equal(t, []string{"Filmes", "Series", "Documentarios"}, names)
+	assert.Empty(t, reported.list())
+}
+
+func TestGivenAClosedBus_WhenCallPublish_ThenShouldReportTheEventAsUndelivered(t *testing.T) {
+	bus, reported := newBus(t)
+	eventbus.Subscribe(bus, func(context.Context, category.CategoryCreated) error {
+		return nil
+	}, eventbus.WithMode(eventbus.Async))
+	require.NoError(t, bus.Close(t.Context()))
+
+	bus.Publish(t.Context(), created("Filmes"))
+
+	require.Len(t, reported.list(), 1)
+	assert.ErrorIs(t, reported.list()[0].Err, eventbus.ErrClosed)
+}
@@ -0,0 +1,328 @@
+package gatewaytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// RunCastMemberGatewaySuite runs the CastMemberGateway contract against a
+// fresh, empty gateway returned by newGateway for each subtest.
+func RunCastMemberGatewaySuite(t *testing.T, newGateway func(t *testing.T) castmember.CastMemberGateway) {
+	t.Run("CreateAndFindByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+
+		created, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		assertSameCastMember(t, c, created)
+
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+		assertSameCastMember(t, c, found)
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		_, err = gateway.Create(t.Context(), c)
+		assert.ErrorIs(t, err, castmember.ErrCastMemberAlreadyExists)
+	})
+
+	t.Run("UpdateExisting", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Update(nil, "Greta Gerwig", castmember.Director))
+		_, err = gateway.Update(t.Context(), c)
+		require.NoError(t, err)
+
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+		assertSameCastMember(t, c, found)
+	})
+
+	t.Run("KeepsPendingEventsWithTheCaller", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+
+		created, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		require.NoError(t, c.Update(nil, "Vin Diesel", castmember.Director))
+		updated, err := gateway.Update(t.Context(), c)
+		require.NoError(t, err)
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+
+		assert.Empty(t, created.PullEvents())
+		assert.Empty(t, updated.PullEvents())
+		assert.Empty(t, found.PullEvents())
+		assert.Len(t, c.PullEvents(), 2)
+	})
+
+	t.Run("UpdateUnknown", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		_, err := gateway.Update(t.Context(), newCastMember(t, "Vin Diesel", castmember.Actor))
+		assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+	})
+
+	t.Run("FindUnknown", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		_, err := gateway.FindByID(t.Context(), castmember.NewCastMemberID())
+		assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		require.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+		_, err = gateway.FindByID(t.Context(), c.ID)
+		assert.ErrorIs(t, err, castmember.ErrCastMemberNotFound)
+		assert.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+	})
+
+	t.Run("FindAllMatchesTermsAndType", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "Vin Diesel", castmember.Actor),
+			newCastMember(t, "Martin Scorsese", castmember.Director),
+			newCastMember(t, "Kevin Costner", castmember.Director),
+			newCastMember(t, "Greta Gerwig", castmember.Director),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: " VIN "})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Kevin Costner", "Vin Diesel"}, castMemberNames(result.Items))
+
+		result, err = gateway.FindAll(t.Context(), pagination.SearchQuery{
+			PerPage: 10,
+			Terms:   "in",
+			Filters: map[string]string{"type": "director"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Total)
+		assert.Equal(t, []string{"Kevin Costner", "Martin Scorsese"}, castMemberNames(result.Items))
+	})
+
+	t.Run("FindAllSorts", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "Vin Diesel", castmember.Actor),
+			newCastMember(t, "Martin Scorsese", castmember.Director),
+			newCastMember(t, "keanu Reeves", castmember.Actor),
+			newCastMember(t, "Greta Gerwig", castmember.Director),
+		)
+
+		for _, tc := range []struct {
+			sort, direction string
+			want            []string
+		}{
+			{"", "", []string{"Greta Gerwig", "keanu Reeves", "Martin Scorsese", "Vin Diesel"}},
+			{"name", "asc", []string{"Greta Gerwig", "keanu Reeves", "Martin Scorsese", "Vin Diesel"}},
+			{"name", "desc", []string{"Vin Diesel", "Martin Scorsese", "keanu Reeves", "Greta Gerwig"}},
+			{"type", "asc", []string{"keanu Reeves", "Vin Diesel", "Greta Gerwig", "Martin Scorsese"}},
+			{"type", "desc", []string{"Martin Scorsese", "Greta Gerwig", "Vin Diesel", "keanu Reeves"}},
+			{"created_at", "asc", []string{"Vin Diesel", "Martin Scorsese", "keanu Reeves", "Greta Gerwig"}},
+			{"created_at", "desc", []string{"Greta Gerwig", "keanu Reeves", "Martin Scorsese", "Vin Diesel"}},
+		} {
+			result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: tc.sort, Direction: tc.direction})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, castMemberNames(result.Items), "sort=%q direction=%q", tc.sort, tc.direction)
+		}
+	})
+
+	t.Run("FindAllBreaksTiesByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "John Smith", castmember.Actor),
+			newCastMember(t, "John Smith", castmember.Director),
+			newCastMember(t, "John Smith", castmember.Actor),
+		)
+
+		for _, direction := range []string{"asc", "desc"} {
+			result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: "name", Direction: direction})
+			require.NoError(t, err)
+			require.Len(t, result.Items, 3)
+			assert.Less(t, result.Items[0].ID.String(), result.Items[1].ID.String())
+			assert.Less(t, result.Items[1].ID.String(), result.Items[2].ID.String())
+		}
+	})
+
+	t.Run("FindAllPaginates", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "AAA", castmember.Actor),
+			newCastMember(t, "BBB", castmember.Actor),
+			newCastMember(t, "CCC", castmember.Director),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{Page: 1, PerPage: 2})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), result.Total)
+		assert.Equal(t, int64(2), result.TotalPages)
+		assert.True(t, result.IsLast)
+		assert.Equal(t, []string{"CCC"}, castMemberNames(result.Items))
+
+		for _, query := range []pagination.SearchQuery{
+			{Page: 2, PerPage: 2},
+			{Page: 0, PerPage: 0},
+			{Page: 922337203685477581, PerPage: 10},
+		} {
+			result, err := gateway.FindAll(t.Context(), query)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), result.Total)
+			assert.NotNil(t, result.Items)
+			assert.Empty(t, result.Items)
+		}
+	})
+
+	t.Run("FindAllByCursorOnACompositeSort", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "Vin Diesel", castmember.Actor),
+			newCastMember(t, "Martin Scorsese", castmember.Director),
+			newCastMember(t, "keanu Reeves", castmember.Actor),
+			newCastMember(t, "Greta Gerwig", castmember.Director),
+			newCastMember(t, "Ava DuVernay", castmember.Director),
+		)
+		query := pagination.CursorQuery{Size: 2, Sort: "type", Direction: "desc"}
+
+		var forward []string
+		page, err := gateway.FindAllByCursor(t.Context(), query)
+		require.NoError(t, err)
+		for {
+			forward = append(forward, castMemberNames(page.Items)...)
+			if page.Next == nil {
+				break
+			}
+			query.After = page.Next
+			page, err = gateway.FindAllByCursor(t.Context(), query)
+			require.NoError(t, err)
+		}
+		assert.Equal(t,
+			[]string{"Martin Scorsese", "Greta Gerwig", "Ava DuVernay", "Vin Diesel", "keanu Reeves"},
+			forward,
+		)
+
+		var backward []string
+		query.After = nil
+		for page.Prev != nil {
+			query.Before = page.Prev
+			page, err = gateway.FindAllByCursor(t.Context(), query)
+			require.NoError(t, err)
+			backward = append(castMemberNames(page.Items), backward...)
+		}
+		assert.Equal(t, forward[:4], backward)
+	})
+
+	t.Run("FindAllByCursorAppliesFilters", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCastMembers(t, gateway,
+			newCastMember(t, "Vin Diesel", castmember.Actor),
+			newCastMember(t, "Martin Scorsese", castmember.Director),
+			newCastMember(t, "Greta Gerwig", castmember.Director),
+		)
+
+		page, err := gateway.FindAllByCursor(t.Context(), pagination.CursorQuery{
+			Size:      5,
+			Sort:      "created_at",
+			Direction: "asc",
+			Filters:   map[string]string{"type": "DIRECTOR"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Martin Scorsese", "Greta Gerwig"}, castMemberNames(page.Items))
+		assert.Nil(t, page.Next)
+		assert.Nil(t, page.Prev)
+	})
+
+	t.Run("FindAllRejectsInvalidQueries", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		for _, query := range []pagination.SearchQuery{
+			{Page: -1, PerPage: 10},
+			{PerPage: 10, Sort: "updated_at"},
+			{PerPage: 10, Direction: "sideways"},
+			{PerPage: 10, Filters: map[string]string{"age": "30"}},
+		} {
+			_, err := gateway.FindAll(t.Context(), query)
+			assert.Error(t, err, "%+v", query)
+		}
+	})
+
+	t.Run("GivesUpOnACanceledContext", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCastMember(t, "Vin Diesel", castmember.Actor)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, err = gateway.Create(ctx, newCastMember(t, "Keanu Reeves", castmember.Actor))
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.Update(ctx, c)
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindByID(ctx, c.ID)
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindAll(ctx, pagination.SearchQuery{PerPage: 10})
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindAllByCursor(ctx, pagination.CursorQuery{Size: 10})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, gateway.DeleteByID(ctx, c.ID), context.Canceled)
+
+		_, err = gateway.FindByID(t.Context(), c.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func newCastMember(t *testing.T, name string, castMemberType castmember.CastMemberType) *castmember.CastMember {
+	t.Helper()
+	c, err := castmember.NewCastMember(nil, name, castMemberType)
+	require.NoError(t, err)
+	return c
+}
+
+// seedCastMembers creates the given cast members one hour apart, in order.
+func seedCastMembers(t *testing.T, gateway castmember.CastMemberGateway, castMembers ...*castmember.CastMember) {
+	t.Helper()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, c := range castMembers {
+		c.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		c.UpdatedAt = c.CreatedAt
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+}
+
+func assertSameCastMember(t *testing.T, want, got *castmember.CastMember) {
+	t.Helper()
+	require.NotNil(t, got)
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.Name, got.Name)
+	assert.Equal(t, want.Type, got.Type)
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt), "created_at: want %v, got %v", want.CreatedAt, got.CreatedAt)
+	assert.True(t, want.UpdatedAt.Equal(got.UpdatedAt), "updated_at: want %v, got %v", want.UpdatedAt, got.UpdatedAt)
+}
+
+func castMemberNames(items []castmember.CastMember) []string {
+	names := make([]string, len(items))
+	for i, c := range items {
+		names[i] = c.Name
+	}
+	return names
+}
@@ -0,0 +1,450 @@
+package gatewaytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// RunCategoryGatewaySuite runs the CategoryGateway contract against a fresh,
+// empty gateway returned by newGateway for each subtest.
+func RunCategoryGatewaySuite(t *testing.T, newGateway func(t *testing.T) category.CategoryGateway) {
+	t.Run("CreateAndFindByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "A categoria mais assistida", true)
+
+		created, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		assertSameCategory(t, c, created)
+
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+		assertSameCategory(t, c, found)
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "", true)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		_, err = gateway.Create(t.Context(), c)
+		assert.ErrorIs(t, err, category.ErrCategoryAlreadyExists)
+	})
+
+	t.Run("UpdateExisting", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "", true)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Update(nil, "Series", "Atualizada", false))
+		_, err = gateway.Update(t.Context(), c)
+		require.NoError(t, err)
+
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+		assertSameCategory(t, c, found)
+		assert.NotNil(t, found.DeletedAt)
+	})
+
+	t.Run("KeepsPendingEventsWithTheCaller", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "", true)
+
+		created, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		require.NoError(t, c.Update(nil, "Series", "", true))
+		updated, err := gateway.Update(t.Context(), c)
+		require.NoError(t, err)
+		found, err := gateway.FindByID(t.Context(), c.ID)
+		require.NoError(t, err)
+
+		assert.Empty(t, created.PullEvents())
+		assert.Empty(t, updated.PullEvents())
+		assert.Empty(t, found.PullEvents())
+		assert.Len(t, c.PullEvents(), 2)
+	})
+
+	t.Run("UpdateUnknown", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		_, err := gateway.Update(t.Context(), newCategory(t, "Filmes", "", true))
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+	})
+
+	t.Run("FindUnknown", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		_, err := gateway.FindByID(t.Context(), category.NewCategoryID())
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "", true)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+
+		require.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+		_, err = gateway.FindByID(t.Context(), c.ID)
+		assert.ErrorIs(t, err, category.ErrCategoryNotFound)
+		assert.NoError(t, gateway.DeleteByID(t.Context(), c.ID))
+	})
+
+	t.Run("FindAllMatchesTermsOnNameAndDescription", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "Filmes", "", true),
+			newCategory(t, "Series", "Melhores FILMES em capitulos", true),
+			newCategory(t, "Documentarios", "", true),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: " filmes "})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Total)
+		assert.Equal(t, []string{"Filmes", "Series"}, categoryNames(result.Items))
+	})
+
+	t.Run("FindAllTreatsTermsLiterally", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "100% Nacional", "", true),
+			newCategory(t, "1000 Filmes", "", true),
+			newCategory(t, "Filmes_Antigos", "", true),
+			newCategory(t, "Filmes Antigos", "", true),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "0%"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"100% Nacional"}, categoryNames(result.Items))
+
+		result, err = gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "s_a"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Filmes_Antigos"}, categoryNames(result.Items))
+	})
+
+	t.Run("FindAllSorts", func(t *testing.T) {
+		gateway := newGateway(t)
+		filmes := newCategory(t, "filmes", "", true)
+		series := newCategory(t, "Series", "", true)
+		animes := newCategory(t, "Animes", "", true)
+		seedCategories(t, gateway, filmes, series, animes)
+		series.UpdatedAt = series.UpdatedAt.Add(24 * time.Hour)
+		_, err := gateway.Update(t.Context(), series)
+		require.NoError(t, err)
+
+		for _, tc := range []struct {
+			sort, direction string
+			want            []string
+		}{
+			{"", "", []string{"Animes", "filmes", "Series"}},
+			{"name", "asc", []string{"Animes", "filmes", "Series"}},
+			{"name", "desc", []string{"Series", "filmes", "Animes"}},
+			{"created_at", "asc", []string{"filmes", "Series", "Animes"}},
+			{"created_at", "desc", []string{"Animes", "Series", "filmes"}},
+			{"updated_at", "asc", []string{"filmes", "Animes", "Series"}},
+			{"updated_at", "desc", []string{"Series", "Animes", "filmes"}},
+		} {
+			result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: tc.sort, Direction: tc.direction})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, categoryNames(result.Items), "sort=%q direction=%q", tc.sort, tc.direction)
+		}
+	})
+
+	t.Run("FindAllBreaksTiesByID", func(t *testing.T) {
+		gateway := newGateway(t)
+		first := newCategory(t, "Filmes", "", true)
+		second := newCategory(t, "Filmes", "", true)
+		seedCategories(t, gateway, first, second)
+
+		for _, direction := range []string{"asc", "desc"} {
+			result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: "name", Direction: direction})
+			require.NoError(t, err)
+			require.Len(t, result.Items, 2)
+			assert.Less(t, result.Items[0].ID.String(), result.Items[1].ID.String())
+		}
+	})
+
+	t.Run("FindAllPaginates", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "AAA", "", true),
+			newCategory(t, "BBB", "", true),
+			newCategory(t, "CCC", "", true),
+			newCategory(t, "DDD", "", true),
+			newCategory(t, "EEE", "", true),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{Page: 1, PerPage: 2, Sort: "name"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.CurrentPage)
+		assert.Equal(t, 2, result.PerPage)
+		assert.Equal(t, int64(5), result.Total)
+		assert.Equal(t, int64(3), result.TotalPages)
+		assert.True(t, result.HasNext)
+		assert.True(t, result.HasPrevious)
+		assert.Equal(t, []string{"CCC", "DDD"}, categoryNames(result.Items))
+
+		for _, query := range []pagination.SearchQuery{
+			{Page: 3, PerPage: 2},
+			{Page: 0, PerPage: 0},
+			{Page: 922337203685477581, PerPage: 10},
+		} {
+			result, err := gateway.FindAll(t.Context(), query)
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), result.Total)
+			assert.NotNil(t, result.Items)
+			assert.Empty(t, result.Items)
+		}
+	})
+
+	t.Run("FindAllByCursorTraversesBothWays", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "EEE", "", true),
+			newCategory(t, "bbb", "", true),
+			newCategory(t, "DDD", "", true),
+			newCategory(t, "AAA", "", true),
+			newCategory(t, "CCC", "", true),
+		)
+		query := pagination.CursorQuery{Size: 2, Sort: "name", Direction: "asc"}
+
+		var pages [][]string
+		page, err := gateway.FindAllByCursor(t.Context(), query)
+		require.NoError(t, err)
+		assert.Nil(t, page.Prev)
+		for {
+			pages = append(pages, categoryNames(page.Items))
+			if page.Next == nil {
+				break
+			}
+			query.After, query.Before = page.Next, nil
+			page, err = gateway.FindAllByCursor(t.Context(), query)
+			require.NoError(t, err)
+			require.NotNil(t, page.Prev)
+		}
+		assert.Equal(t, [][]string{{"AAA", "bbb"}, {"CCC", "DDD"}, {"EEE"}}, pages)
+
+		query.After, query.Before = nil, page.Prev
+		page, err = gateway.FindAllByCursor(t.Context(), query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"CCC", "DDD"}, categoryNames(page.Items))
+		require.NotNil(t, page.Next)
+
+		query.Before = page.Prev
+		page, err = gateway.FindAllByCursor(t.Context(), query)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"AAA", "bbb"}, categoryNames(page.Items))
+		assert.Nil(t, page.Prev)
+		assert.NotNil(t, page.Next)
+	})
+
+	t.Run("FindAllByCursorFoldsCaseBeyondASCII", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "ÁRVORE", "", true),
+			newCategory(t, "ação", "", true),
+			newCategory(t, "Ábaco", "", true),
+			newCategory(t, "Zebra", "", true),
+			newCategory(t, "égua", "", true),
+			newCategory(t, "Éden", "", true),
+		)
+
+		query := pagination.CursorQuery{Size: 2, Sort: "name", Direction: "asc"}
+		var names []string
+		for {
+			page, err := gateway.FindAllByCursor(t.Context(), query)
+			require.NoError(t, err)
+			names = append(names, categoryNames(page.Items)...)
+			if page.Next == nil {
+				break
+			}
+			query.After = page.Next
+		}
+		assert.Equal(t, []string{"ação", "Zebra", "Ábaco", "ÁRVORE", "Éden", "égua"}, names)
+
+		found, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "AÇÃO"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ação"}, categoryNames(found.Items))
+	})
+
+	t.Run("FindAllFoldsDescriptionsBeyondASCII", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "Filmes", "FILMES DE AÇÃO", true),
+			newCategory(t, "Series", "Dramas", true),
+		)
+
+		result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "ação"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Total)
+		assert.Equal(t, []string{"Filmes"}, categoryNames(result.Items))
+	})
+
+	t.Run("FindAllByCursorDescendingWithTies", func(t *testing.T) {
+		gateway := newGateway(t)
+		categories := []*category.Category{
+			newCategory(t, "AAA", "", true),
+			newCategory(t, "BBB", "", true),
+			newCategory(t, "CCC", "", true),
+			newCategory(t, "DDD", "", true),
+		}
+		seedCategories(t, gateway, categories...)
+		require.NoError(t, categories[1].Update(nil, "BBB", "", true))
+		categories[1].UpdatedAt = categories[2].UpdatedAt
+		_, err := gateway.Update(t.Context(), categories[1])
+		require.NoError(t, err)
+
+		query := pagination.CursorQuery{Size: 1, Sort: "updated_at", Direction: "desc"}
+		var names []string
+		for {
+			page, err := gateway.FindAllByCursor(t.Context(), query)
+			require.NoError(t, err)
+			names = append(names, categoryNames(page.Items)...)
+			if page.Next == nil {
+				break
+			}
+			query.After = page.Next
+		}
+
+		require.Len(t, names, 4)
+		assert.Equal(t, "DDD", names[0])
+		assert.ElementsMatch(t, []string{"BBB", "CCC"}, names[1:3])
+		assert.Equal(t, "AAA", names[3])
+	})
+
+	t.Run("FindAllByCursorIsStableUnderInserts", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway,
+			newCategory(t, "BBB", "", true),
+			newCategory(t, "CCC", "", true),
+			newCategory(t, "DDD", "", true),
+			newCategory(t, "EEE", "", true),
+		)
+		query := pagination.CursorQuery{Size: 2, Sort: "name", Direction: "asc"}
+		first, err := gateway.FindAllByCursor(t.Context(), query)
+		require.NoError(t, err)
+
+		for _, name := range []string{"AAA", "BBA", "FFF"} {
+			_, err := gateway.Create(t.Context(), newCategory(t, name, "", true))
+			require.NoError(t, err)
+		}
+		query.After = first.Next
+		second, err := gateway.FindAllByCursor(t.Context(), query)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"BBB", "CCC"}, categoryNames(first.Items))
+		assert.Equal(t, []string{"DDD", "EEE"}, categoryNames(second.Items))
+	})
+
+	t.Run("FindAllByCursorRejectsInvalidQueries", func(t *testing.T) {
+		gateway := newGateway(t)
+		seedCategories(t, gateway, newCategory(t, "AAA", "", true), newCategory(t, "BBB", "", true))
+		page, err := gateway.FindAllByCursor(t.Context(), pagination.CursorQuery{Size: 1, Sort: "name", Direction: "asc"})
+		require.NoError(t, err)
+		require.NotNil(t, page.Next)
+
+		for _, query := range []pagination.CursorQuery{
+			{Size: 0, Sort: "name", Direction: "asc"},
+			{Size: 1, Sort: "created_at", Direction: "asc", After: page.Next},
+			{Size: 1, Sort: "name", Direction: "desc", After: page.Next},
+			{Size: 1, Sort: "name", Direction: "asc", After: page.Next, Before: page.Next},
+			{Size: 1, Sort: "bogus", Direction: "asc"},
+		} {
+			_, err := gateway.FindAllByCursor(t.Context(), query)
+			assert.Error(t, err, "%+v", query)
+		}
+	})
+
+	t.Run("FindAllRejectsInvalidQueries", func(t *testing.T) {
+		gateway := newGateway(t)
+
+		for _, query := range []pagination.SearchQuery{
+			{Page: -1, PerPage: 10},
+			{PerPage: 10, Sort: "name; DROP TABLE categories"},
+			{PerPage: 10, Direction: "sideways"},
+			{PerPage: 10, Filters: map[string]string{"type": "ACTOR"}},
+		} {
+			_, err := gateway.FindAll(t.Context(), query)
+			assert.Error(t, err, "%+v", query)
+		}
+	})
+
+	t.Run("GivesUpOnACanceledContext", func(t *testing.T) {
+		gateway := newGateway(t)
+		c := newCategory(t, "Filmes", "", true)
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		_, err = gateway.Create(ctx, newCategory(t, "Series", "", true))
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.Update(ctx, c)
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindByID(ctx, c.ID)
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindAll(ctx, pagination.SearchQuery{PerPage: 10})
+		assert.ErrorIs(t, err, context.Canceled)
+		_, err = gateway.FindAllByCursor(ctx, pagination.CursorQuery{Size: 10})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, gateway.DeleteByID(ctx, c.ID), context.Canceled)
+
+		_, err = gateway.FindByID(t.Context(), c.ID)
+		assert.NoError(t, err)
+	})
+}
+
+func newCategory(t *testing.T, name, description string, active bool) *category.Category {
+	t.Helper()
+	c, err := category.NewCategory(nil, name, description, active)
+	require.NoError(t, err)
+	return c
+}
+
+// seedCategories creates the given categories one hour apart, in order, so
+// created_at ordering is deterministic regardless of clock resolution.
+func seedCategories(t *testing.T, gateway category.CategoryGateway, categories ...*category.Category) {
+	t.Helper()
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, c := range categories {
+		c.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		c.UpdatedAt = c.CreatedAt
+		_, err := gateway.Create(t.Context(), c)
+		require.NoError(t, err)
+	}
+}
+
+// assertSameCategory compares categories field by field, using time.Equal so
+// gateways that normalise time zones still satisfy the contract.
+func assertSameCategory(t *testing.T, want, got *category.Category) {
+	t.Helper()
+	require.NotNil(t, got)
+	assert.Equal(t, want.ID, got.ID)
+	assert.Equal(t, want.Name, got.Name)
+	assert.Equal(t, want.Description, got.Description)
+	assert.Equal(t, want.Active, got.Active)
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt), "created_at: want %v, got %v", want.CreatedAt, got.CreatedAt)
+	assert.True(t, want.UpdatedAt.Equal(got.UpdatedAt), "updated_at: want %v, got %v", want.UpdatedAt, got.UpdatedAt)
+	if want.DeletedAt == nil {
+		assert.Nil(t, got.DeletedAt)
+	} else if assert.NotNil(t, got.DeletedAt) {
+		assert.True(t, want.DeletedAt.Equal(*got.DeletedAt), "deleted_at: want %v, got %v", want.DeletedAt, got.DeletedAt)
+	}
+}
+
+func categoryNames(items []category.Category) []string {
+	names := make([]string, len(items))
+	for i, c := range items {
+		names[i] = c.Name
+	}
+	return names
+}
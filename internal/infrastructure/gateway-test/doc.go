@@ -0,0 +1,4 @@
+// Package gatewaytest holds behavioural contracts shared by every gateway
+// implementation. Adapters plug in through a factory returning a fresh, empty
+// gateway, so memory, SQL or any future storage is held to the same rules.
+package gatewaytest
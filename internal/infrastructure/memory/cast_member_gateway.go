@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"strings"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type CastMemberGateway = Store[castmember.CastMember, castmember.CastMemberID]
+
+var _ castmember.CastMemberGateway = (*CastMemberGateway)(nil)
+
+func NewCastMemberGateway() *CastMemberGateway {
+	return NewStore(StoreConfig[castmember.CastMember, castmember.CastMemberID]{
+		Key: func(c *castmember.CastMember) castmember.CastMemberID { return c.ID },
+		SearchFields: func(c *castmember.CastMember) []string {
+			return []string{c.Name}
+		},
+		Sorts: []SortField[castmember.CastMember]{
+			{Name: "name", Key: func(c *castmember.CastMember) []string {
+				return []string{pagination.TextKey(c.Name)}
+			}},
+			{Name: "type", Key: func(c *castmember.CastMember) []string {
+				return []string{string(c.Type), pagination.TextKey(c.Name)}
+			}},
+			{Name: "created_at", Key: func(c *castmember.CastMember) []string {
+				return []string{pagination.TimeKey(c.CreatedAt)}
+			}},
+		},
+		Filters: []FilterField[castmember.CastMember]{
+			{Name: "type", Match: func(c *castmember.CastMember, value string) bool {
+				return string(c.Type) == strings.ToUpper(strings.TrimSpace(value))
+			}},
+		},
+		ErrNotFound:      castmember.ErrCastMemberNotFound,
+		ErrAlreadyExists: castmember.ErrCastMemberAlreadyExists,
+	})
+}
@@ -0,0 +1,39 @@
+package memory_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenConcurrentAccess_WhenCallCastMemberGateway_ThenShouldBeSafe(t *testing.T) {
+	gateway := memory.NewCastMemberGateway()
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := castmember.NewCastMember(nil, "Vin Diesel", castmember.Actor)
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, err = gateway.Create(t.Context(), c)
+			assert.NoError(t, err)
+			assert.NoError(t, c.Update(nil, "Vin Diesel", castmember.Director))
+			_, err = gateway.Update(t.Context(), c)
+			assert.NoError(t, err)
+			_, err = gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 5, Sort: "type"})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), result.Total)
+}
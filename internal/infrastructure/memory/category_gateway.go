@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type CategoryGateway = Store[category.Category, category.CategoryID]
+
+var _ category.CategoryGateway = (*CategoryGateway)(nil)
+
+func NewCategoryGateway() *CategoryGateway {
+	return NewStore(StoreConfig[category.Category, category.CategoryID]{
+		Key: func(c *category.Category) category.CategoryID { return c.ID },
+		SearchFields: func(c *category.Category) []string {
+			return []string{c.Name, c.Description}
+		},
+		Sorts: []SortField[category.Category]{
+			{Name: "name", Key: func(c *category.Category) []string { return []string{pagination.TextKey(c.Name)} }},
+			{Name: "created_at", Key: func(c *category.Category) []string { return []string{pagination.TimeKey(c.CreatedAt)} }},
+			{Name: "updated_at", Key: func(c *category.Category) []string { return []string{pagination.TimeKey(c.UpdatedAt)} }},
+		},
+		Clone: func(c category.Category) category.Category {
+			c.DeletedAt = clonePointer(c.DeletedAt)
+			return c
+		},
+		ErrNotFound:      category.ErrCategoryNotFound,
+		ErrAlreadyExists: category.ErrCategoryAlreadyExists,
+	})
+}
@@ -0,0 +1,81 @@
+package memory_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func newCategory(t *testing.T, name, description string) *category.Category {
+	t.Helper()
+	c, err := category.NewCategory(nil, name, description, true)
+	require.NoError(t, err)
+	return c
+}
+
+func TestGivenAValidCategory_WhenCallCreate_ThenShouldPersistACopy(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	c := newCategory(t, "Filmes", "A categoria mais assistida")
+
+	created, err := gateway.Create(t.Context(), c)
+	assert.NoError(t, err)
+	assert.Empty(t, created.PullEvents(), "the saved copy must not carry pending events")
+	assert.Len(t, c.PullEvents(), 1, "the caller keeps its pending events")
+	assert.Equal(t, c, created)
+
+	c.Name = "Alterado fora do gateway"
+	found, err := gateway.FindByID(t.Context(), c.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Filmes", found.Name)
+}
+
+func TestGivenAnInactiveCategory_WhenMutatingDeletedAt_ThenStoredCopyShouldNotChange(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+	c, err := category.NewCategory(nil, "Filmes", "", false)
+	require.NoError(t, err)
+	deletedAt := *c.DeletedAt
+
+	created, err := gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	*c.DeletedAt = deletedAt.Add(time.Hour)
+	*created.DeletedAt = deletedAt.Add(2 * time.Hour)
+
+	found, err := gateway.FindByID(t.Context(), c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deletedAt, *found.DeletedAt)
+	*found.DeletedAt = deletedAt.Add(3 * time.Hour)
+
+	again, err := gateway.FindByID(t.Context(), c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deletedAt, *again.DeletedAt)
+}
+
+func TestGivenConcurrentWriters_WhenCallCreate_ThenShouldPersistAll(t *testing.T) {
+	gateway := memory.NewCategoryGateway()
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := category.NewCategory(nil, "Filmes", "", true)
+			if assert.NoError(t, err) {
+				_, err = gateway.Create(t.Context(), c)
+				assert.NoError(t, err)
+			}
+			_, err = gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), result.Total)
+}
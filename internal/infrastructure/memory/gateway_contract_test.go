@@ -0,0 +1,22 @@
+package memory_test
+
+import (
+	"testing"
+
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	gatewaytest "github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/gateway-test"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestCategoryGatewaySuite(t *testing.T) {
+	gatewaytest.RunCategoryGatewaySuite(t, func(t *testing.T) category.CategoryGateway {
+		return memory.NewCategoryGateway()
+	})
+}
+
+func TestCastMemberGatewaySuite(t *testing.T) {
+	gatewaytest.RunCastMemberGatewaySuite(t, func(t *testing.T) castmember.CastMemberGateway {
+		return memory.NewCastMemberGateway()
+	})
+}
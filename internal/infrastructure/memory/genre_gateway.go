@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"slices"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/genre"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+type GenreGateway = Store[genre.Genre, genre.GenreID]
+
+var _ genre.GenreGateway = (*GenreGateway)(nil)
+
+func NewGenreGateway() *GenreGateway {
+	return NewStore(StoreConfig[genre.Genre, genre.GenreID]{
+		Key: func(g *genre.Genre) genre.GenreID { return g.ID },
+		SearchFields: func(g *genre.Genre) []string {
+			return []string{g.Name}
+		},
+		Sorts: []SortField[genre.Genre]{
+			{Name: "name", Key: func(g *genre.Genre) []string { return []string{pagination.TextKey(g.Name)} }},
+			{Name: "created_at", Key: func(g *genre.Genre) []string { return []string{pagination.TimeKey(g.CreatedAt)} }},
+			{Name: "updated_at", Key: func(g *genre.Genre) []string { return []string{pagination.TimeKey(g.UpdatedAt)} }},
+		},
+		Clone: func(g genre.Genre) genre.Genre {
+			g.CategoryIDs = slices.Clone(g.CategoryIDs)
+			g.DeletedAt = clonePointer(g.DeletedAt)
+			return g
+		},
+		ErrNotFound:      genre.ErrGenreNotFound,
+		ErrAlreadyExists: genre.ErrGenreAlreadyExists,
+	})
+}
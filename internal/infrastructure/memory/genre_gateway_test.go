@@ -0,0 +1,51 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/genre"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func TestGivenAGenre_WhenCallCreateAndMutateTheOriginal_ThenStoredCopyShouldNotChange(t *testing.T) {
+	gateway := memory.NewGenreGateway()
+	g, err := genre.NewGenre(nil, "Action", true, category.NewCategoryID())
+	require.NoError(t, err)
+
+	_, err = gateway.Create(t.Context(), g)
+	require.NoError(t, err)
+	g.CategoryIDs[0] = category.NewCategoryID()
+	g.AddCategory(nil, category.NewCategoryID())
+
+	found, err := gateway.FindByID(t.Context(), g.ID)
+	require.NoError(t, err)
+	assert.Len(t, found.CategoryIDs, 1)
+	assert.NotEqual(t, g.CategoryIDs[0], found.CategoryIDs[0])
+
+	_, err = gateway.Create(t.Context(), g)
+	assert.ErrorIs(t, err, genre.ErrGenreAlreadyExists)
+}
+
+func TestGivenGenres_WhenCallFindAll_ThenShouldSearchAndSortByName(t *testing.T) {
+	gateway := memory.NewGenreGateway()
+	for _, name := range []string{"Drama", "Action", "Adventure"} {
+		g, err := genre.NewGenre(nil, name, true)
+		require.NoError(t, err)
+		_, err = gateway.Create(t.Context(), g)
+		require.NoError(t, err)
+	}
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "a", Direction: "desc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.Total)
+	assert.Equal(t, []string{"Drama", "Adventure", "Action"},
+		[]string{result.Items[0].Name, result.Items[1].Name, result.Items[2].Name})
+
+	_, err = gateway.FindByID(t.Context(), genre.NewGenreID())
+	assert.ErrorIs(t, err, genre.ErrGenreNotFound)
+}
@@ -0,0 +1,69 @@
+package memory
+
+import "context"
+
+// rwLock is a readers-writer lock whose waiters give up once their context is
+// done, which sync.RWMutex cannot do. Readers share the lock; a writer holds
+// it alone. Like a plain readers-preferring lock, a steady stream of readers
+// can keep a writer waiting, which the context then bounds.
+type rwLock struct {
+	// write is held by a writer, or by the readers as a group.
+	write chan struct{}
+	// readers guards the reader count.
+	readers chan struct{}
+	count   int
+}
+
+func newRWLock() rwLock {
+	return rwLock{
+		write:   make(chan struct{}, 1),
+		readers: make(chan struct{}, 1),
+	}
+}
+
+func (l *rwLock) Lock(ctx context.Context) error {
+	return acquire(ctx, l.write)
+}
+
+func (l *rwLock) Unlock() {
+	<-l.write
+}
+
+// RLock joins the readers. The first one in takes the write slot for the
+// group, so it is the only reader that can wait on a writer.
+func (l *rwLock) RLock(ctx context.Context) error {
+	if err := acquire(ctx, l.readers); err != nil {
+		return err
+	}
+	defer func() { <-l.readers }()
+
+	if l.count == 0 {
+		if err := acquire(ctx, l.write); err != nil {
+			return err
+		}
+	}
+	l.count++
+	return nil
+}
+
+func (l *rwLock) RUnlock() {
+	l.readers <- struct{}{}
+	defer func() { <-l.readers }()
+
+	l.count--
+	if l.count == 0 {
+		<-l.write
+	}
+}
+
+func acquire(ctx context.Context, slot chan struct{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case slot <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
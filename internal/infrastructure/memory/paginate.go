@@ -0,0 +1,15 @@
+package memory
+
+import "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+
+func paginate[T any](items []T, query pagination.SearchQuery) (*pagination.Pagination[T], error) {
+	total := int64(len(items))
+	// Compare the page with the last one before multiplying, so a huge page
+	// number cannot overflow into a negative slice bound.
+	if query.Page < 0 || query.PerPage <= 0 || len(items) == 0 || query.Page > (len(items)-1)/query.PerPage {
+		return pagination.New[T](query, total, nil)
+	}
+	start := query.Page * query.PerPage
+	end := min(start+query.PerPage, len(items))
+	return pagination.New(query, total, items[start:end:end])
+}
@@ -0,0 +1,267 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/event"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+// SortField is a named ordering FindAll accepts in SearchQuery.Sort. Key
+// returns the entity's sort keys, encoded with the pagination key helpers so
+// that comparing them as strings gives the listing order.
+type SortField[T any] struct {
+	Name string
+	Key  func(entity *T) []string
+}
+
+// FilterField is a named predicate FindAll accepts in SearchQuery.Filters.
+type FilterField[T any] struct {
+	Name  string
+	Match func(entity *T, value string) bool
+}
+
+// StoreConfig describes how a Store reads an aggregate. The first entry of
+// Sorts is the default ordering when SearchQuery.Sort is empty.
+type StoreConfig[T any, ID interface {
+	comparable
+	fmt.Stringer
+}] struct {
+	Key              func(entity *T) ID
+	SearchFields     func(entity *T) []string
+	Sorts            []SortField[T]
+	Filters          []FilterField[T]
+	Clone            func(entity T) T
+	ErrNotFound      error
+	ErrAlreadyExists error
+}
+
+// Store is an in-memory, concurrency-safe gateway.Gateway for any aggregate.
+// It keeps copies of the entities it is given, so callers never share state
+// with the store.
+type Store[T any, ID interface {
+	comparable
+	fmt.Stringer
+}] struct {
+	config StoreConfig[T, ID]
+	// mu guards items. Unlike a sync.RWMutex, a caller waiting for it can
+	// give up when its context is done.
+	mu    rwLock
+	items map[ID]T
+}
+
+func NewStore[T any, ID interface {
+	comparable
+	fmt.Stringer
+}](config StoreConfig[T, ID]) *Store[T, ID] {
+	if config.Clone == nil {
+		config.Clone = func(entity T) T { return entity }
+	}
+	return &Store[T, ID]{
+		config: config,
+		mu:     newRWLock(),
+		items:  make(map[ID]T),
+	}
+}
+
+func (s *Store[T, ID]) Create(ctx context.Context, entity *T) (*T, error) {
+	if err := s.mu.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+
+	id := s.config.Key(entity)
+	if _, ok := s.items[id]; ok {
+		return nil, s.config.ErrAlreadyExists
+	}
+	s.items[id] = s.clone(*entity)
+	created := s.clone(*entity)
+	return &created, nil
+}
+
+func (s *Store[T, ID]) Update(ctx context.Context, entity *T) (*T, error) {
+	if err := s.mu.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer s.mu.Unlock()
+
+	id := s.config.Key(entity)
+	if _, ok := s.items[id]; !ok {
+		return nil, s.config.ErrNotFound
+	}
+	s.items[id] = s.clone(*entity)
+	updated := s.clone(*entity)
+	return &updated, nil
+}
+
+func (s *Store[T, ID]) DeleteByID(ctx context.Context, id ID, _ ...event.Event) error {
+	if err := s.mu.Lock(ctx); err != nil {
+		return err
+	}
+	defer s.mu.Unlock()
+
+	delete(s.items, id)
+	return nil
+}
+
+func (s *Store[T, ID]) FindByID(ctx context.Context, id ID) (*T, error) {
+	if err := s.mu.RLock(ctx); err != nil {
+		return nil, err
+	}
+	defer s.mu.RUnlock()
+
+	entity, ok := s.items[id]
+	if !ok {
+		return nil, s.config.ErrNotFound
+	}
+	found := s.clone(entity)
+	return &found, nil
+}
+
+func (s *Store[T, ID]) FindAll(ctx context.Context, query pagination.SearchQuery) (*pagination.Pagination[T], error) {
+	items, err := s.search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return paginate(items, query)
+}
+
+func (s *Store[T, ID]) FindAllByCursor(ctx context.Context, query pagination.CursorQuery) (*pagination.CursorPage[T], error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	items, err := s.search(ctx, query.SearchQuery())
+	if err != nil {
+		return nil, err
+	}
+
+	field, _ := s.sortField(query.Sort)
+	desc, _ := query.SearchQuery().IsDescending()
+	position := func(entity *T) ([]string, string) {
+		return field.Key(entity), s.config.Key(entity).String()
+	}
+
+	cursor, backward := query.Position()
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(items), func(i int) bool {
+			keys, id := position(&items[i])
+			cmp := pagination.ComparePosition(keys, id, cursor.Keys, cursor.ID, desc)
+			if backward {
+				return cmp >= 0
+			}
+			return cmp > 0
+		})
+	}
+
+	var fetched []T
+	if backward {
+		if cursor == nil {
+			start = len(items)
+		}
+		fetched = slices.Clone(items[max(start-query.Size-1, 0):start])
+		slices.Reverse(fetched)
+	} else {
+		fetched = items[start:min(start+query.Size+1, len(items))]
+	}
+	return pagination.NewCursorPage(query, fetched, position), nil
+}
+
+// search returns every entity matching the query's terms and filters, in
+// listing order.
+func (s *Store[T, ID]) search(ctx context.Context, query pagination.SearchQuery) ([]T, error) {
+	field, err := s.sortField(query.Sort)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := query.IsDescending()
+	if err != nil {
+		return nil, err
+	}
+	filters := make([]string, len(s.config.Filters))
+	for i, filter := range s.config.Filters {
+		filters[i] = filter.Name
+	}
+	if err := query.CheckFilters(filters...); err != nil {
+		return nil, err
+	}
+
+	if err := s.mu.RLock(ctx); err != nil {
+		return nil, err
+	}
+	items := make([]T, 0, len(s.items))
+	terms := strings.ToLower(strings.TrimSpace(query.Terms))
+	for _, entity := range s.items {
+		if err := ctx.Err(); err != nil {
+			s.mu.RUnlock()
+			return nil, err
+		}
+		if s.matches(&entity, terms, query.Filters) {
+			items = append(items, s.clone(entity))
+		}
+	}
+	s.mu.RUnlock()
+
+	keys := make(map[ID][]string, len(items))
+	for i := range items {
+		keys[s.config.Key(&items[i])] = field.Key(&items[i])
+	}
+	slices.SortFunc(items, func(a, b T) int {
+		aID, bID := s.config.Key(&a), s.config.Key(&b)
+		return pagination.ComparePosition(keys[aID], aID.String(), keys[bID], bID.String(), desc)
+	})
+	return items, nil
+}
+
+// clone copies entity with the configured Clone, dropping the domain events
+// it has recorded: those belong to the caller that raised them, so the store
+// neither keeps nor hands them out.
+func (s *Store[T, ID]) clone(entity T) T {
+	cloned := s.config.Clone(entity)
+	if source, ok := any(&cloned).(interface{ PullEvents() []event.Event }); ok {
+		source.PullEvents()
+	}
+	return cloned
+}
+
+// clonePointer copies the value behind p, for Clone functions of aggregates
+// holding pointers.
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	clone := *p
+	return &clone
+}
+
+func (s *Store[T, ID]) matches(entity *T, terms string, filters map[string]string) bool {
+	for _, filter := range s.config.Filters {
+		if value, ok := filters[filter.Name]; ok && strings.TrimSpace(value) != "" && !filter.Match(entity, value) {
+			return false
+		}
+	}
+	if terms == "" || s.config.SearchFields == nil {
+		return true
+	}
+	for _, field := range s.config.SearchFields(entity) {
+		if strings.Contains(strings.ToLower(field), terms) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store[T, ID]) sortField(name string) (SortField[T], error) {
+	names := make([]string, len(s.config.Sorts))
+	for i, field := range s.config.Sorts {
+		if field.Name == name || (name == "" && i == 0) {
+			return field, nil
+		}
+		names[i] = field.Name
+	}
+	return SortField[T]{}, pagination.SearchQuery{Sort: name}.CheckSort(names...)
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+)
+
+func TestGivenABusyStore_WhenTheDeadlinePasses_ThenShouldAbandonTheQuery(t *testing.T) {
+	gateway := NewCategoryGateway()
+	require.NoError(t, gateway.mu.Lock(t.Context()))
+	defer gateway.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+
+	_, err := gateway.FindAll(ctx, pagination.SearchQuery{PerPage: 10})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestGivenABusyStore_WhenTheCallerCancels_ThenShouldAbandonTheWrite(t *testing.T) {
+	gateway := NewCategoryGateway()
+	require.NoError(t, gateway.mu.Lock(t.Context()))
+	defer gateway.mu.Unlock()
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() {
+		_, err := gateway.Create(ctx, c)
+		done <- err
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("Create kept waiting for the store after its context was canceled")
+	}
+	assert.Empty(t, gateway.items)
+}
+
+func TestGivenAReaderHoldingTheStore_WhenAnotherReaderArrives_ThenShouldNotWait(t *testing.T) {
+	gateway := NewCategoryGateway()
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+	_, err = gateway.Create(t.Context(), c)
+	require.NoError(t, err)
+	require.NoError(t, gateway.mu.RLock(t.Context()))
+	defer gateway.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	found, err := gateway.FindByID(ctx, c.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Filmes", found.Name)
+	_, err = gateway.FindAll(ctx, pagination.SearchQuery{PerPage: 10})
+	assert.NoError(t, err)
+}
+
+func TestGivenAReaderHoldingTheStore_WhenAWriterArrives_ThenShouldWaitForTheReader(t *testing.T) {
+	gateway := NewCategoryGateway()
+	require.NoError(t, gateway.mu.RLock(t.Context()))
+	c, err := category.NewCategory(nil, "Filmes", "", true)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+	_, err = gateway.Create(ctx, c)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	gateway.mu.RUnlock()
+	_, err = gateway.Create(t.Context(), c)
+	assert.NoError(t, err)
+}
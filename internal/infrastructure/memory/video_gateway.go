@@ -0,0 +1,42 @@
+package memory
+
+import (
+	"slices"
+
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/video"
+)
+
+type VideoGateway = Store[video.Video, video.VideoID]
+
+var _ video.VideoGateway = (*VideoGateway)(nil)
+
+func NewVideoGateway() *VideoGateway {
+	return NewStore(StoreConfig[video.Video, video.VideoID]{
+		Key: func(v *video.Video) video.VideoID { return v.ID },
+		SearchFields: func(v *video.Video) []string {
+			return []string{v.Title, v.Description}
+		},
+		Sorts: []SortField[video.Video]{
+			{Name: "title", Key: func(v *video.Video) []string { return []string{pagination.TextKey(v.Title)} }},
+			{Name: "launch_year", Key: func(v *video.Video) []string { return []string{pagination.IntKey(v.LaunchYear)} }},
+			{Name: "created_at", Key: func(v *video.Video) []string { return []string{pagination.TimeKey(v.CreatedAt)} }},
+			{Name: "updated_at", Key: func(v *video.Video) []string { return []string{pagination.TimeKey(v.UpdatedAt)} }},
+		},
+		Clone:            cloneVideo,
+		ErrNotFound:      video.ErrVideoNotFound,
+		ErrAlreadyExists: video.ErrVideoAlreadyExists,
+	})
+}
+
+func cloneVideo(v video.Video) video.Video {
+	v.CategoryIDs = slices.Clone(v.CategoryIDs)
+	v.GenreIDs = slices.Clone(v.GenreIDs)
+	v.CastMemberIDs = slices.Clone(v.CastMemberIDs)
+	v.Banner = clonePointer(v.Banner)
+	v.Thumbnail = clonePointer(v.Thumbnail)
+	v.ThumbnailHalf = clonePointer(v.ThumbnailHalf)
+	v.Trailer = clonePointer(v.Trailer)
+	v.VideoMedia = clonePointer(v.VideoMedia)
+	return v
+}
@@ -0,0 +1,57 @@
+package memory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/pagination"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/video"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/memory"
+)
+
+func newVideo(t *testing.T, title string, launchYear int) *video.Video {
+	t.Helper()
+	v, err := video.NewVideo(nil, title, "description", launchYear, 2*time.Hour, false, true, video.RatingL, nil, nil, nil)
+	require.NoError(t, err)
+	return v
+}
+
+func TestGivenAVideoWithMedia_WhenMutateTheOriginal_ThenStoredCopyShouldNotChange(t *testing.T) {
+	gateway := memory.NewVideoGateway()
+	v := newVideo(t, "Velozes e Furiosos", 2001)
+	media, err := video.NewAudioVideoMedia("checksum", "video.mp4", "/raw/video.mp4")
+	require.NoError(t, err)
+	require.NoError(t, v.SetVideoMedia(nil, media))
+
+	_, err = gateway.Create(t.Context(), v)
+	require.NoError(t, err)
+	require.NoError(t, v.ProcessingMedia(nil, video.MediaTypeVideo))
+	v.VideoMedia.Name = "changed.mp4"
+
+	found, err := gateway.FindByID(t.Context(), v.ID)
+	require.NoError(t, err)
+	assert.Equal(t, video.MediaStatusPending, found.VideoMedia.Status)
+	assert.Equal(t, "video.mp4", found.VideoMedia.Name)
+}
+
+func TestGivenVideos_WhenCallFindAllSortedByLaunchYear_ThenShouldOrderThem(t *testing.T) {
+	gateway := memory.NewVideoGateway()
+	for _, v := range []*video.Video{
+		newVideo(t, "Matrix", 1999),
+		newVideo(t, "Matrix Reloaded", 2003),
+		newVideo(t, "Inception", 2010),
+	} {
+		_, err := gateway.Create(t.Context(), v)
+		require.NoError(t, err)
+	}
+
+	result, err := gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Terms: "matrix", Sort: "launch_year", Direction: "desc"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, "Matrix Reloaded", result.Items[0].Title)
+
+	_, err = gateway.FindAll(t.Context(), pagination.SearchQuery{PerPage: 10, Sort: "name"})
+	assert.EqualError(t, err, "'sort' must be one of 'title', 'launch_year', 'created_at' or 'updated_at', got 'name'")
+}
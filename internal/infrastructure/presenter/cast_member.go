@@ -0,0 +1,21 @@
+package presenter
+
+import castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+
+type CastMemberResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	CreatedAt Timestamp `json:"created_at"`
+	UpdatedAt Timestamp `json:"updated_at"`
+}
+
+func NewCastMemberResponse(output castmemberusecase.CastMemberOutput) CastMemberResponse {
+	return CastMemberResponse{
+		ID:        output.ID,
+		Name:      output.Name,
+		Type:      output.Type,
+		CreatedAt: NewTimestamp(output.CreatedAt),
+		UpdatedAt: NewTimestamp(output.UpdatedAt),
+	}
+}
@@ -0,0 +1,49 @@
+package presenter_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	castmemberusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/cast-member-usecase"
+	castmember "github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/cast-member"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/presenter"
+)
+
+func TestGivenACastMember_WhenMarshalCastMemberResponse_ThenShouldUseSnakeCaseFields(t *testing.T) {
+	createdAt := time.Date(2024, 5, 10, 12, 30, 0, 0, time.UTC)
+	response := presenter.NewCastMemberResponse(castmemberusecase.CastMemberOutput{
+		ID:        "6f1c2f3a-9a4b-4c3d-8e5f-0a1b2c3d4e5f",
+		Name:      "Vin Diesel",
+		Type:      "ACTOR",
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt.Add(time.Second),
+	})
+
+	data, err := json.Marshal(response)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "6f1c2f3a-9a4b-4c3d-8e5f-0a1b2c3d4e5f",
+		"name": "Vin Diesel",
+		"type": "ACTOR",
+		"created_at": "2024-05-10T12:30:00.000000Z",
+		"updated_at": "2024-05-10T12:30:01.000000Z"
+	}`, string(data))
+}
+
+func TestGivenACastMember_WhenRoundTripCastMemberResponse_ThenShouldDecodeTheSameValue(t *testing.T) {
+	c, err := castmember.NewCastMember(nil, "Greta Gerwig", castmember.Director)
+	require.NoError(t, err)
+	response := presenter.NewCastMemberResponse(castmemberusecase.NewCastMemberOutput(*c))
+
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	var decoded presenter.CastMemberResponse
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, response, decoded)
+	assert.True(t, c.UpdatedAt.Equal(decoded.UpdatedAt.Time))
+}
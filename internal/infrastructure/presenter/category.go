@@ -0,0 +1,25 @@
+package presenter
+
+import categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+
+type CategoryResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	IsActive    bool       `json:"is_active"`
+	CreatedAt   Timestamp  `json:"created_at"`
+	UpdatedAt   Timestamp  `json:"updated_at"`
+	DeletedAt   *Timestamp `json:"deleted_at"`
+}
+
+func NewCategoryResponse(output categoryusecase.CategoryOutput) CategoryResponse {
+	return CategoryResponse{
+		ID:          output.ID,
+		Name:        output.Name,
+		Description: output.Description,
+		IsActive:    output.IsActive,
+		CreatedAt:   NewTimestamp(output.CreatedAt),
+		UpdatedAt:   NewTimestamp(output.UpdatedAt),
+		DeletedAt:   newOptionalTimestamp(output.DeletedAt),
+	}
+}
@@ -0,0 +1,54 @@
+package presenter_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	categoryusecase "github.com/williamsbgomes/admin-catalogo-video-go/internal/application/category-usecase"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/domain/category"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/presenter"
+)
+
+func TestGivenACategory_WhenMarshalCategoryResponse_ThenShouldUseSnakeCaseFields(t *testing.T) {
+	createdAt := time.Date(2024, 5, 10, 12, 30, 0, 123456000, time.UTC)
+	response := presenter.NewCategoryResponse(categoryusecase.CategoryOutput{
+		ID:          "6f1c2f3a-9a4b-4c3d-8e5f-0a1b2c3d4e5f",
+		Name:        "Filmes",
+		Description: "A categoria mais assistida",
+		IsActive:    true,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	})
+
+	data, err := json.Marshal(response)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "6f1c2f3a-9a4b-4c3d-8e5f-0a1b2c3d4e5f",
+		"name": "Filmes",
+		"description": "A categoria mais assistida",
+		"is_active": true,
+		"created_at": "2024-05-10T12:30:00.123456Z",
+		"updated_at": "2024-05-10T12:30:00.123456Z",
+		"deleted_at": null
+	}`, string(data))
+}
+
+func TestGivenAnInactiveCategory_WhenRoundTripCategoryResponse_ThenShouldDecodeTheSameValue(t *testing.T) {
+	c, err := category.NewCategory(nil, "Filmes", "A categoria mais assistida", false)
+	require.NoError(t, err)
+	response := presenter.NewCategoryResponse(categoryusecase.NewCategoryOutput(*c))
+
+	data, err := json.Marshal(response)
+	require.NoError(t, err)
+	var decoded presenter.CategoryResponse
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, response, decoded)
+	require.NotNil(t, decoded.DeletedAt)
+	assert.True(t, c.DeletedAt.Equal(decoded.DeletedAt.Time))
+	assert.True(t, c.CreatedAt.Equal(decoded.CreatedAt.Time))
+}
@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimestampLayout is RFC3339 with a fixed microsecond fraction, matching the
+// precision entities are truncated to by timeutils.
+const TimestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+// Timestamp encodes a time.Time in UTC using TimestampLayout.
+type Timestamp struct {
+	time.Time
+}
+
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{t.UTC().Truncate(time.Microsecond)}
+}
+
+func newOptionalTimestamp(t *time.Time) *Timestamp {
+	if t == nil {
+		return nil
+	}
+	timestamp := NewTimestamp(*t)
+	return &timestamp
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.UTC().Format(TimestampLayout) + `"`), nil
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("timestamp must be a JSON string, got %s", data)
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, string(data[1:len(data)-1]))
+	if err != nil {
+		return fmt.Errorf("timestamp must be RFC3339: %w", err)
+	}
+	*t = NewTimestamp(parsed)
+	return nil
+}
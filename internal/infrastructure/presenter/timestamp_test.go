@@ -0,0 +1,43 @@
+package presenter_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/williamsbgomes/admin-catalogo-video-go/internal/infrastructure/presenter"
+)
+
+func TestGivenATime_WhenMarshalTimestamp_ThenShouldUseUTCWithMicroseconds(t *testing.T) {
+	saoPaulo := time.FixedZone("BRT", -3*60*60)
+	timestamp := presenter.NewTimestamp(time.Date(2024, 5, 10, 9, 30, 0, 123456789, saoPaulo))
+
+	data, err := json.Marshal(timestamp)
+
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-05-10T12:30:00.123456Z"`, string(data))
+}
+
+func TestGivenAWholeSecond_WhenMarshalTimestamp_ThenShouldKeepTheFraction(t *testing.T) {
+	data, err := json.Marshal(presenter.NewTimestamp(time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-05-10T12:00:00.000000Z"`, string(data))
+}
+
+func TestGivenAnRFC3339String_WhenUnmarshalTimestamp_ThenShouldParseToUTC(t *testing.T) {
+	var timestamp presenter.Timestamp
+
+	require.NoError(t, json.Unmarshal([]byte(`"2024-05-10T09:30:00.123456-03:00"`), &timestamp))
+
+	assert.Equal(t, time.Date(2024, 5, 10, 12, 30, 0, 123456000, time.UTC), timestamp.Time)
+}
+
+func TestGivenAnInvalidValue_WhenUnmarshalTimestamp_ThenShouldReceiveAnError(t *testing.T) {
+	for _, input := range []string{`"10/05/2024"`, `1715340600`, `""`} {
+		var timestamp presenter.Timestamp
+		assert.Error(t, json.Unmarshal([]byte(input), &timestamp), input)
+	}
+}
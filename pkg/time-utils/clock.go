@@ -0,0 +1,84 @@
+package timeutils
+
+import (
+	"sync"
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+// Now reads the given clock truncated to microseconds, falling back to the
+// system clock when none is set.
+func Now(clock Clock) time.Time {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	return clock.Now().Truncate(time.Microsecond)
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	frozen bool
+	anchor time.Time
+}
+
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{
+		now:    now,
+		frozen: true,
+	}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.frozen {
+		return c.now
+	}
+	return c.now.Add(time.Since(c.anchor))
+}
+
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+	c.anchor = time.Now()
+}
+
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func (c *FakeClock) Freeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.frozen {
+		c.now = c.now.Add(time.Since(c.anchor))
+		c.frozen = true
+	}
+}
+
+func (c *FakeClock) Unfreeze() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.frozen {
+		c.anchor = time.Now()
+		c.frozen = false
+	}
+}
@@ -0,0 +1,76 @@
+package timeutils
+
+import (
+	"testing"
+	"time"
+)
+
+var fakeStart = time.Date(2024, 5, 10, 12, 0, 0, 123456789, time.UTC)
+
+func TestSystemClock_TruncatesToMicrosecond(t *testing.T) {
+	now := SystemClock{}.Now()
+	if now.Nanosecond()%1000 != 0 {
+		t.Errorf("expected microsecond precision, got %d nanoseconds", now.Nanosecond())
+	}
+}
+
+func TestNow_TruncatesAnyClock(t *testing.T) {
+	got := Now(NewFakeClock(fakeStart))
+	want := fakeStart.Truncate(time.Microsecond)
+	if !got.Equal(want) {
+		t.Errorf("Now() = %v; want %v", got, want)
+	}
+}
+
+func TestNow_FallsBackToSystemClock(t *testing.T) {
+	before := time.Now().Add(-time.Millisecond)
+	got := Now(nil)
+	after := time.Now().Add(time.Millisecond)
+
+	if got.Before(before) || got.After(after) {
+		t.Errorf("Now(nil) = %v; want between %v and %v", got, before, after)
+	}
+}
+
+func TestFakeClock_IsFrozenByDefault(t *testing.T) {
+	clock := NewFakeClock(fakeStart)
+	first := clock.Now()
+	time.Sleep(2 * time.Millisecond)
+
+	if got := clock.Now(); !got.Equal(first) {
+		t.Errorf("Now() = %v; want %v", got, first)
+	}
+}
+
+func TestFakeClock_SetAndAdvance(t *testing.T) {
+	clock := NewFakeClock(fakeStart)
+
+	clock.Advance(time.Hour)
+	if got, want := clock.Now(), fakeStart.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("after Advance Now() = %v; want %v", got, want)
+	}
+
+	later := fakeStart.AddDate(1, 0, 0)
+	clock.Set(later)
+	if got := clock.Now(); !got.Equal(later) {
+		t.Errorf("after Set Now() = %v; want %v", got, later)
+	}
+}
+
+func TestFakeClock_UnfreezeAndFreeze(t *testing.T) {
+	clock := NewFakeClock(fakeStart)
+
+	clock.Unfreeze()
+	time.Sleep(2 * time.Millisecond)
+	clock.Freeze()
+
+	frozen := clock.Now()
+	if !frozen.After(fakeStart) {
+		t.Errorf("expected time to pass while unfrozen, got %v", frozen)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	if got := clock.Now(); !got.Equal(frozen) {
+		t.Errorf("Now() = %v; want frozen %v", got, frozen)
+	}
+}
@@ -3,6 +3,6 @@ package timeutils
 import "time"
 
 func TimeNow() *time.Time {
-	now := time.Now().Truncate(time.Microsecond)
+	now := SystemClock{}.Now()
 	return &now
 }